@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAttachmentNotFound    = errors.New("attachment not found")
+	ErrInvalidAttachment     = errors.New("invalid attachment")
+	ErrAttachmentTooLarge    = errors.New("attachment too large")
+	ErrUnsupportedAttachment = errors.New("unsupported attachment type")
+	ErrBlobNotFound          = errors.New("blob not found")
+	// ErrTextNotReady is returned for the text of an attachment whose
+	// extraction is pending, failed or skipped.
+	ErrTextNotReady = errors.New("attachment text is not available")
+)
+
+const (
+	AttachmentKindCV        = "cv"
+	AttachmentKindPortfolio = "portfolio"
+	AttachmentKindOther     = "other"
+)
+
+var AttachmentKinds = []string{AttachmentKindCV, AttachmentKindPortfolio, AttachmentKindOther}
+
+const (
+	ContentTypePDF  = "application/pdf"
+	ContentTypeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	ContentTypeText = "text/plain; charset=utf-8"
+	ContentTypePNG  = "image/png"
+	ContentTypeJPEG = "image/jpeg"
+)
+
+// AttachmentContentTypes lists the accepted types. They are detected from the
+// content, never taken from the client.
+var AttachmentContentTypes = []string{ContentTypePDF, ContentTypeDOCX, ContentTypeText, ContentTypePNG, ContentTypeJPEG}
+
+// Text extraction statuses. Extraction runs in the background after the
+// upload; images are skipped as there is no text to read from them.
+const (
+	TextPending = "pending"
+	TextDone    = "done"
+	TextFailed  = "failed"
+	TextSkipped = "skipped"
+)
+
+// TextContentTypes lists the types text can be extracted from.
+var TextContentTypes = []string{ContentTypePDF, ContentTypeDOCX, ContentTypeText}
+
+// Attachment is a file uploaded for a talent. The content lives in a
+// BlobStore under a key derived from its SHA-256, so the same file uploaded
+// twice is stored once.
+type Attachment struct {
+	Id          uuid.UUID `firestore:"-"`
+	TalentId    string    `firestore:"talent_id"`
+	Kind        string    `firestore:"kind"`
+	FileName    string    `firestore:"file_name"`
+	ContentType string    `firestore:"content_type"`
+	Size        int64     `firestore:"size"`
+	SHA256      string    `firestore:"sha256"`
+	UploadedAt  time.Time `firestore:"uploaded_at"`
+
+	TextStatus  string    `firestore:"text_status"`
+	TextError   string    `firestore:"text_error"`
+	TextLength  int       `firestore:"text_length"`
+	ExtractedAt time.Time `firestore:"extracted_at"`
+	// SuggestedTags are the catalog tags found in the text. They are not
+	// applied to the talent, a recruiter picks the ones that fit.
+	SuggestedTags []string `firestore:"suggested_tags"`
+}
+
+func NewAttachment(talentId string, kind string, fileName string, contentType string, size int64, sum string) (*Attachment, error) {
+	if kind == "" {
+		kind = AttachmentKindOther
+	}
+	attachment := &Attachment{
+		Id:          uuid.New(),
+		TalentId:    talentId,
+		Kind:        strings.ToLower(strings.TrimSpace(kind)),
+		FileName:    CleanFileName(fileName),
+		ContentType: contentType,
+		Size:        size,
+		SHA256:      sum,
+		UploadedAt:  time.Now().UTC(),
+		TextStatus:  TextSkipped,
+	}
+	if slices.Contains(TextContentTypes, contentType) {
+		attachment.TextStatus = TextPending
+	}
+
+	err := attachment.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (a *Attachment) Validate() error {
+	if a.TalentId == "" {
+		return fmt.Errorf("%w: talent is null", ErrInvalidAttachment)
+	}
+	if !slices.Contains(AttachmentKinds, a.Kind) {
+		return fmt.Errorf("%w: kind must be one of %s", ErrInvalidAttachment, strings.Join(AttachmentKinds, ", "))
+	}
+	if a.Size <= 0 {
+		return fmt.Errorf("%w: file is empty", ErrInvalidAttachment)
+	}
+	if !slices.Contains(AttachmentContentTypes, a.ContentType) {
+		return fmt.Errorf("%w: %s", ErrUnsupportedAttachment, a.ContentType)
+	}
+	if len(a.SHA256) != 64 {
+		return fmt.Errorf("%w: checksum is not a SHA-256", ErrInvalidAttachment)
+	}
+	return nil
+}
+
+// BlobKey is where the content is kept in the BlobStore.
+func (a *Attachment) BlobKey() string {
+	return BlobKey(a.SHA256)
+}
+
+func BlobKey(sum string) string {
+	return "sha256/" + sum
+}
+
+// TextBlobKey is where the extracted text is kept. Like the content, it is
+// shared by every upload of the same file.
+func (a *Attachment) TextBlobKey() string {
+	return "text/" + BlobKey(a.SHA256)
+}
+
+func (a *Attachment) RecordText(text string, suggestedTags []string, at time.Time) {
+	a.TextStatus = TextDone
+	a.TextError = ""
+	a.TextLength = utf8.RuneCountInString(text)
+	a.SuggestedTags = suggestedTags
+	a.ExtractedAt = at
+}
+
+// RecordTextFailure is for documents that cannot be read, which would fail
+// again on every attempt.
+func (a *Attachment) RecordTextFailure(err error, at time.Time) {
+	a.TextStatus = TextFailed
+	a.TextError = err.Error()
+	a.ExtractedAt = at
+}
+
+// CleanFileName keeps only the base name, without control characters, so it
+// is safe to send back in Content-Disposition.
+func CleanFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+	if runes := []rune(name); len(runes) > 200 {
+		name = string(runes[len(runes)-200:])
+	}
+	return name
+}
+
+type AttachmentGateway interface {
+	SaveAttachment(ctx context.Context, attachment Attachment) error
+	// GetAttachment returns ErrAttachmentNotFound when the attachment does not
+	// exist or belongs to another talent.
+	GetAttachment(ctx context.Context, talentId string, id string) (*Attachment, error)
+	// GetAttachments returns the talent's attachments, newest first.
+	GetAttachments(ctx context.Context, talentId string) ([]Attachment, error)
+	// GetPendingAttachments returns up to limit attachments waiting for text
+	// extraction.
+	GetPendingAttachments(ctx context.Context, limit int) ([]Attachment, error)
+}
+
+// ExtractionQueue hands a stored attachment to the workers that extract its
+// text.
+type ExtractionQueue interface {
+	Enqueue(attachment Attachment)
+}
+
+// BlobStore keeps attachment contents by key. Put must accept a key that
+// already exists, as content addressed keys are written again when the same
+// file is uploaded twice.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader, contentType string) error
+	// Get returns ErrBlobNotFound for unknown keys.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Exists(ctx context.Context, key string) (bool, error)
+}
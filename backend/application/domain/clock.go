@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// Clock lets background jobs be tested without waiting for real time.
+type Clock interface {
+	Now() time.Time
+}
+
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now().UTC()
+}
@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/currency"
+)
+
+var (
+	ErrInvalidCompensation = errors.New("invalid compensation")
+	// ErrCompensationForbidden is returned when a caller without access to
+	// compensation tries to write or filter on it.
+	ErrCompensationForbidden = errors.New("compensation is restricted to recruiters and admins")
+)
+
+const (
+	PeriodMonthly = "monthly"
+	PeriodYearly  = "yearly"
+)
+
+var Periods = []string{PeriodMonthly, PeriodYearly}
+
+const (
+	ContractCLT        = "clt"
+	ContractPJ         = "pj"
+	ContractContractor = "contractor"
+)
+
+var Contracts = []string{ContractCLT, ContractPJ, ContractContractor}
+
+// Compensation is a salary expectation. Amount is in minor units of Currency,
+// an ISO 4217 code, so 1500000 BRL is R$ 15.000,00.
+type Compensation struct {
+	Amount   int64  `firestore:"amount"`
+	Currency string `firestore:"currency"`
+	Period   string `firestore:"period"`
+	Contract string `firestore:"contract"`
+}
+
+func (c Compensation) IsZero() bool {
+	return c == Compensation{}
+}
+
+func (c Compensation) Normalize() Compensation {
+	c.Currency = strings.ToUpper(strings.TrimSpace(c.Currency))
+	c.Period = strings.ToLower(strings.TrimSpace(c.Period))
+	c.Contract = strings.ToLower(strings.TrimSpace(c.Contract))
+	return c
+}
+
+func (c Compensation) Validate() error {
+	if c.IsZero() {
+		return nil
+	}
+	if c.Amount <= 0 {
+		return fmt.Errorf("%w: amount must be positive", ErrInvalidCompensation)
+	}
+	if err := validateCurrency(c.Currency); err != nil {
+		return err
+	}
+	if !slices.Contains(Periods, c.Period) {
+		return fmt.Errorf("%w: period must be one of %s", ErrInvalidCompensation, strings.Join(Periods, ", "))
+	}
+	if !slices.Contains(Contracts, c.Contract) {
+		return fmt.Errorf("%w: contract must be one of %s", ErrInvalidCompensation, strings.Join(Contracts, ", "))
+	}
+	return nil
+}
+
+// Yearly returns the amount for a whole year, so monthly and yearly
+// expectations can be compared.
+func (c Compensation) Yearly() int64 {
+	if c.Period == PeriodMonthly {
+		return c.Amount * 12
+	}
+	return c.Amount
+}
+
+// CompensationFilter selects talents whose expectation is within Min and Max,
+// both optional and expressed in Currency per Period. Amounts in other
+// currencies never match, as there is no conversion.
+type CompensationFilter struct {
+	Currency string `firestore:"currency"`
+	Period   string `firestore:"period"`
+	Min      int64  `firestore:"min"`
+	Max      int64  `firestore:"max"`
+	Contract string `firestore:"contract"`
+}
+
+func (f CompensationFilter) IsZero() bool {
+	return f == CompensationFilter{}
+}
+
+// Normalize keeps an empty filter empty, so it does not count as a
+// compensation filter once stored.
+func (f CompensationFilter) Normalize() CompensationFilter {
+	if f.IsZero() {
+		return f
+	}
+	f.Currency = strings.ToUpper(strings.TrimSpace(f.Currency))
+	f.Period = strings.ToLower(strings.TrimSpace(f.Period))
+	if f.Period == "" {
+		f.Period = PeriodMonthly
+	}
+	f.Contract = strings.ToLower(strings.TrimSpace(f.Contract))
+	return f
+}
+
+func (f CompensationFilter) Validate() error {
+	if f.IsZero() {
+		return nil
+	}
+	if f.Min < 0 || f.Max < 0 || (f.Max > 0 && f.Min > f.Max) {
+		return fmt.Errorf("%w: the range must have 0 <= min <= max", ErrInvalidCompensation)
+	}
+	if (f.Min > 0 || f.Max > 0) && f.Currency == "" {
+		return fmt.Errorf("%w: currency is required with min or max", ErrInvalidCompensation)
+	}
+	if f.Currency != "" {
+		if err := validateCurrency(f.Currency); err != nil {
+			return err
+		}
+	}
+	if !slices.Contains(Periods, f.Period) {
+		return fmt.Errorf("%w: period must be one of %s", ErrInvalidCompensation, strings.Join(Periods, ", "))
+	}
+	if f.Contract != "" && !slices.Contains(Contracts, f.Contract) {
+		return fmt.Errorf("%w: contract must be one of %s", ErrInvalidCompensation, strings.Join(Contracts, ", "))
+	}
+	return nil
+}
+
+// Matches expects a normalized filter.
+func (f CompensationFilter) Matches(c Compensation) bool {
+	if f.Currency == "" && f.Contract == "" && f.Min == 0 && f.Max == 0 {
+		return true
+	}
+	if c.IsZero() {
+		return false
+	}
+	if f.Contract != "" && f.Contract != c.Contract {
+		return false
+	}
+	if f.Currency != "" && f.Currency != c.Currency {
+		return false
+	}
+	yearly := c.Yearly()
+	if f.Min > 0 && yearly < (Compensation{Amount: f.Min, Period: f.Period}).Yearly() {
+		return false
+	}
+	if f.Max > 0 && yearly > (Compensation{Amount: f.Max, Period: f.Period}).Yearly() {
+		return false
+	}
+	return true
+}
+
+func validateCurrency(code string) error {
+	_, err := currency.ParseISO(code)
+	if err != nil || len(code) != 3 {
+		return fmt.Errorf("%w: %q is not an ISO 4217 currency code", ErrInvalidCompensation, code)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSetCompensationNormalizes(t *testing.T) {
+	talent, _ := Create("https://linkedin.com/in/test", "Backend Engineer", "John Doe", "Developer", "", "", nil, "")
+	err := talent.SetCompensation(Compensation{Amount: 1500000, Currency: "brl", Period: "Monthly", Contract: " PJ "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := Compensation{Amount: 1500000, Currency: "BRL", Period: PeriodMonthly, Contract: ContractPJ}
+	if talent.Compensation != expected {
+		t.Errorf("expected %+v, got %+v", expected, talent.Compensation)
+	}
+
+	if err := talent.SetCompensation(Compensation{}); err != nil || !talent.Compensation.IsZero() {
+		t.Errorf("expected the compensation to be cleared, got %+v %v", talent.Compensation, err)
+	}
+}
+
+func TestCompensationValidate(t *testing.T) {
+	valid := Compensation{Amount: 9000000, Currency: "EUR", Period: PeriodYearly, Contract: ContractContractor}
+	tests := map[string]func(c *Compensation){
+		"amount":   func(c *Compensation) { c.Amount = -1 },
+		"currency": func(c *Compensation) { c.Currency = "XYZ" },
+		"period":   func(c *Compensation) { c.Period = "weekly" },
+		"contract": func(c *Compensation) { c.Contract = "freelance" },
+	}
+	for name, change := range tests {
+		c := valid
+		change(&c)
+		if err := c.Validate(); !errors.Is(err, ErrInvalidCompensation) {
+			t.Errorf("%s: expected ErrInvalidCompensation, got %v", name, err)
+		}
+	}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCompensationFilterComparesYearlyAmounts(t *testing.T) {
+	monthly := Compensation{Amount: 1000000, Currency: "BRL", Period: PeriodMonthly, Contract: ContractCLT}
+	yearly := Compensation{Amount: 15000000, Currency: "BRL", Period: PeriodYearly, Contract: ContractPJ}
+	euro := Compensation{Amount: 500000, Currency: "EUR", Period: PeriodMonthly, Contract: ContractContractor}
+
+	filter := CompensationFilter{Currency: "brl", Min: 1100000, Max: 1300000}.Normalize()
+	if err := filter.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if filter.Matches(monthly) || !filter.Matches(yearly) || filter.Matches(euro) || filter.Matches(Compensation{}) {
+		t.Errorf("expected only the yearly expectation within R$ 11.000 and R$ 13.000 a month")
+	}
+
+	if !(CompensationFilter{}).Normalize().Matches(Compensation{}) {
+		t.Error("expected an empty filter to match talents without compensation")
+	}
+	if err := (CompensationFilter{Min: 1}).Normalize().Validate(); !errors.Is(err, ErrInvalidCompensation) {
+		t.Errorf("expected currency to be required with a range, got %v", err)
+	}
+}
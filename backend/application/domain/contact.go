@@ -0,0 +1,296 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrInvalidContact  = errors.New("invalid contact")
+	ErrContactConflict = errors.New("contact belongs to another talent")
+)
+
+const (
+	NetworkLinkedIn      = "linkedin"
+	NetworkTwitter       = "twitter"
+	NetworkGitLab        = "gitlab"
+	NetworkStackOverflow = "stackoverflow"
+	NetworkMedium        = "medium"
+	NetworkBehance       = "behance"
+	NetworkDribbble      = "dribbble"
+	NetworkOther         = "other"
+)
+
+var Networks = []string{
+	NetworkLinkedIn, NetworkTwitter, NetworkGitLab, NetworkStackOverflow, NetworkMedium, NetworkBehance,
+	NetworkDribbble, NetworkOther,
+}
+
+// networkHosts is used to fill the network of profiles sent without one.
+var networkHosts = map[string]string{
+	"linkedin.com":      NetworkLinkedIn,
+	"twitter.com":       NetworkTwitter,
+	"x.com":             NetworkTwitter,
+	"gitlab.com":        NetworkGitLab,
+	"stackoverflow.com": NetworkStackOverflow,
+	"medium.com":        NetworkMedium,
+	"behance.net":       NetworkBehance,
+	"dribbble.com":      NetworkDribbble,
+}
+
+// DefaultPhoneCountryCode is assumed for national numbers typed without one.
+const DefaultPhoneCountryCode = "55"
+
+var (
+	e164Pattern     = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+	githubPattern   = regexp.MustCompile(`^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$`)
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "", "/", "")
+)
+
+// Contacts holds the ways to reach a talent besides ProfileURL. Values are
+// kept normalized: lowercase emails, E.164 phones, GitHub usernames and
+// absolute http(s) URLs.
+type Contacts struct {
+	Emails  []string        `firestore:"emails"`
+	Phones  []string        `firestore:"phones"`
+	GitHub  string          `firestore:"github"`
+	Website string          `firestore:"website"`
+	Socials []SocialProfile `firestore:"socials"`
+}
+
+type SocialProfile struct {
+	Network string `firestore:"network"`
+	URL     string `firestore:"url"`
+}
+
+func (c Contacts) IsEmpty() bool {
+	return len(c.Emails) == 0 && len(c.Phones) == 0 && c.GitHub == "" && c.Website == "" && len(c.Socials) == 0
+}
+
+// Normalize validates every contact point and returns them normalized, with
+// duplicates removed.
+func (c Contacts) Normalize() (Contacts, error) {
+	var normalized Contacts
+	for _, value := range c.Emails {
+		email, err := NormalizeEmail(value)
+		if err != nil {
+			return Contacts{}, err
+		}
+		if !slices.Contains(normalized.Emails, email) {
+			normalized.Emails = append(normalized.Emails, email)
+		}
+	}
+	for _, value := range c.Phones {
+		phone, err := NormalizePhone(value)
+		if err != nil {
+			return Contacts{}, err
+		}
+		if !slices.Contains(normalized.Phones, phone) {
+			normalized.Phones = append(normalized.Phones, phone)
+		}
+	}
+	if strings.TrimSpace(c.GitHub) != "" {
+		github, err := NormalizeGitHub(c.GitHub)
+		if err != nil {
+			return Contacts{}, err
+		}
+		normalized.GitHub = github
+	}
+	if strings.TrimSpace(c.Website) != "" {
+		website, err := NormalizeWebsite(c.Website)
+		if err != nil {
+			return Contacts{}, err
+		}
+		normalized.Website = website
+	}
+	for _, social := range c.Socials {
+		profile, err := social.normalize()
+		if err != nil {
+			return Contacts{}, err
+		}
+		if !slices.ContainsFunc(normalized.Socials, func(existing SocialProfile) bool { return existing.URL == profile.URL }) {
+			normalized.Socials = append(normalized.Socials, profile)
+		}
+	}
+	return normalized, nil
+}
+
+func (s SocialProfile) normalize() (SocialProfile, error) {
+	link, err := NormalizeWebsite(s.URL)
+	if err != nil {
+		return SocialProfile{}, err
+	}
+	network := strings.ToLower(strings.TrimSpace(s.Network))
+	if network == "" {
+		parsed, _ := url.Parse(link)
+		network = networkHosts[strings.TrimPrefix(parsed.Hostname(), "www.")]
+	}
+	if network == "" {
+		network = NetworkOther
+	}
+	if !slices.Contains(Networks, network) {
+		return SocialProfile{}, fmt.Errorf("%w: network must be one of %s", ErrInvalidContact, strings.Join(Networks, ", "))
+	}
+	return SocialProfile{Network: network, URL: link}, nil
+}
+
+// Keys returns one lookup key per contact point, profileURL included.
+func (c Contacts) Keys(profileURL string) []string {
+	var keys []string
+	add := func(key string) {
+		if key != "" && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	for _, email := range c.Emails {
+		add(emailKey(email))
+	}
+	for _, phone := range c.Phones {
+		add(phoneKey(phone))
+	}
+	if c.GitHub != "" {
+		add(githubKey(c.GitHub))
+	}
+	add(urlKey(c.Website))
+	for _, social := range c.Socials {
+		add(urlKey(social.URL))
+	}
+	add(urlKey(profileURL))
+	return keys
+}
+
+// EmailKeys returns the keys of the emails only, the ones that must not be
+// shared between talents.
+func (c Contacts) EmailKeys() []string {
+	keys := make([]string, 0, len(c.Emails))
+	for _, email := range c.Emails {
+		keys = append(keys, emailKey(email))
+	}
+	return keys
+}
+
+// ContactLookupKeys returns the keys a free text handle may match: an email,
+// a phone number, a GitHub username or any profile URL.
+func ContactLookupKeys(handle string) []string {
+	handle = strings.TrimSpace(handle)
+	var keys []string
+	if email, err := NormalizeEmail(handle); err == nil {
+		return []string{emailKey(email)}
+	}
+	if phone, err := NormalizePhone(handle); err == nil {
+		keys = append(keys, phoneKey(phone))
+	}
+	if github, err := NormalizeGitHub(handle); err == nil {
+		keys = append(keys, githubKey(github))
+	}
+	if strings.ContainsAny(handle, "./") {
+		if key := urlKey(handle); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func NormalizeEmail(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Address != value {
+		return "", fmt.Errorf("%w: %q is not an email address", ErrInvalidContact, value)
+	}
+	domain := value[strings.LastIndex(value, "@")+1:]
+	if !strings.Contains(domain, ".") {
+		return "", fmt.Errorf("%w: %q is not an email address", ErrInvalidContact, value)
+	}
+	return strings.ToLower(value), nil
+}
+
+// NormalizePhone returns the number in E.164. National numbers, with ten or
+// eleven digits after the trunk zero, get DefaultPhoneCountryCode.
+func NormalizePhone(value string) (string, error) {
+	phone := phoneSeparators.Replace(strings.TrimSpace(value))
+	switch {
+	case strings.HasPrefix(phone, "+"):
+	case strings.HasPrefix(phone, "00"):
+		phone = "+" + phone[2:]
+	default:
+		national := strings.TrimPrefix(phone, "0")
+		if len(national) == 10 || len(national) == 11 {
+			phone = "+" + DefaultPhoneCountryCode + national
+		}
+	}
+	if !e164Pattern.MatchString(phone) {
+		return "", fmt.Errorf("%w: %q is not a phone number", ErrInvalidContact, value)
+	}
+	return phone, nil
+}
+
+// NormalizeGitHub accepts a username, with or without @, or a profile URL.
+func NormalizeGitHub(value string) (string, error) {
+	username := strings.TrimPrefix(strings.TrimSpace(value), "@")
+	if strings.Contains(strings.ToLower(username), "github.com") {
+		link, err := NormalizeWebsite(username)
+		if err != nil {
+			return "", err
+		}
+		parsed, _ := url.Parse(link)
+		if strings.TrimPrefix(parsed.Hostname(), "www.") != "github.com" {
+			return "", fmt.Errorf("%w: %q is not a GitHub profile", ErrInvalidContact, value)
+		}
+		username, _, _ = strings.Cut(strings.TrimPrefix(parsed.Path, "/"), "/")
+	}
+	if len(username) > 39 || !githubPattern.MatchString(username) {
+		return "", fmt.Errorf("%w: %q is not a GitHub username", ErrInvalidContact, value)
+	}
+	return username, nil
+}
+
+// NormalizeWebsite returns an absolute http(s) URL, assuming https when the
+// scheme is missing, with a lowercase host and no trailing slash.
+func NormalizeWebsite(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	link := value
+	if !strings.Contains(link, "://") {
+		link = "https://" + link
+	}
+	parsed, err := url.Parse(link)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || !strings.Contains(parsed.Hostname(), ".") {
+		return "", fmt.Errorf("%w: %q is not a web address", ErrInvalidContact, value)
+	}
+	parsed.Host = strings.ToLower(parsed.Host)
+	parsed.Path = strings.TrimSuffix(parsed.Path, "/")
+	parsed.RawPath = ""
+	parsed.Fragment = ""
+	return parsed.String(), nil
+}
+
+func emailKey(email string) string {
+	return "email:" + email
+}
+
+func phoneKey(phone string) string {
+	return "phone:" + phone
+}
+
+func githubKey(username string) string {
+	return "github:" + strings.ToLower(username)
+}
+
+// urlKey ignores the scheme, www, query and letter case, so the same profile
+// copied from different places still matches.
+func urlKey(link string) string {
+	if strings.TrimSpace(link) == "" {
+		return ""
+	}
+	normalized, err := NormalizeWebsite(link)
+	if err != nil {
+		return ""
+	}
+	parsed, _ := url.Parse(normalized)
+	host := strings.TrimPrefix(parsed.Hostname(), "www.")
+	return "url:" + strings.ToLower(host+parsed.Path)
+}
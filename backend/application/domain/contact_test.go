@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestContactsNormalize(t *testing.T) {
+	contacts, err := Contacts{
+		Emails:  []string{" Jane.Doe@Example.com ", "jane.doe@example.com"},
+		Phones:  []string{"(11) 91234-5678", "+1 415 555 0100", "0044 20 7946 0958"},
+		GitHub:  "https://github.com/JaneDoe/",
+		Website: "JaneDoe.dev/",
+		Socials: []SocialProfile{{URL: "https://www.x.com/janedoe"}, {Network: "Medium", URL: "medium.com/@jane"}},
+	}.Normalize()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !slices.Equal(contacts.Emails, []string{"jane.doe@example.com"}) {
+		t.Errorf("unexpected emails %v", contacts.Emails)
+	}
+	if !slices.Equal(contacts.Phones, []string{"+5511912345678", "+14155550100", "+442079460958"}) {
+		t.Errorf("unexpected phones %v", contacts.Phones)
+	}
+	if contacts.GitHub != "JaneDoe" {
+		t.Errorf("expected GitHub username JaneDoe, got %q", contacts.GitHub)
+	}
+	if contacts.Website != "https://janedoe.dev" {
+		t.Errorf("unexpected website %q", contacts.Website)
+	}
+	expected := []SocialProfile{
+		{Network: NetworkTwitter, URL: "https://www.x.com/janedoe"},
+		{Network: NetworkMedium, URL: "https://medium.com/@jane"},
+	}
+	if !slices.Equal(contacts.Socials, expected) {
+		t.Errorf("expected socials %+v, got %+v", expected, contacts.Socials)
+	}
+}
+
+func TestContactsNormalizeRejectsInvalidValues(t *testing.T) {
+	tests := map[string]Contacts{
+		"email":       {Emails: []string{"jane@localhost"}},
+		"named email": {Emails: []string{"Jane <jane@example.com>"}},
+		"phone":       {Phones: []string{"12345"}},
+		"github":      {GitHub: "jane--doe"},
+		"github url":  {GitHub: "https://gitlab.com/jane"},
+		"website":     {Website: "ftp://janedoe.dev"},
+		"network":     {Socials: []SocialProfile{{Network: "orkut", URL: "https://orkut.com/jane"}}},
+	}
+	for name, contacts := range tests {
+		if _, err := contacts.Normalize(); !errors.Is(err, ErrInvalidContact) {
+			t.Errorf("%s: expected ErrInvalidContact, got %v", name, err)
+		}
+	}
+}
+
+func TestContactLookupKeysMatchTalentKeys(t *testing.T) {
+	talent, _ := Create("https://www.linkedin.com/in/jane/", "Backend Engineer", "Jane Doe", "Developer", "", "", nil, "")
+	err := talent.SetContacts(Contacts{
+		Emails: []string{"jane@example.com"},
+		Phones: []string{"+55 11 91234-5678"},
+		GitHub: "@JaneDoe",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, handle := range []string{"JANE@example.com", "11 91234 5678", "janedoe", "github.com/janedoe", "linkedin.com/in/jane"} {
+		keys := ContactLookupKeys(handle)
+		if !slices.ContainsFunc(keys, func(key string) bool { return slices.Contains(talent.ContactKeys, key) }) {
+			t.Errorf("%q: keys %v do not match %v", handle, keys, talent.ContactKeys)
+		}
+	}
+}
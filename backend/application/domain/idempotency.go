@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// IdempotencyRecord keeps the response of a request. Pending records reserve
+// the key while the request runs and have no response yet.
+type IdempotencyRecord struct {
+	Key         string            `firestore:"key"`
+	RequestHash string            `firestore:"request_hash"`
+	Pending     bool              `firestore:"pending"`
+	StatusCode  int               `firestore:"status_code"`
+	Headers     map[string]string `firestore:"headers"`
+	Body        []byte            `firestore:"body"`
+	CreatedAt   time.Time         `firestore:"created_at"`
+	ExpiresAt   time.Time         `firestore:"expires_at"`
+}
+
+func NewIdempotencyRecord(key string, requestHash string, statusCode int, headers map[string]string, body []byte, ttl time.Duration) (*IdempotencyRecord, error) {
+	now := time.Now().UTC()
+	record := &IdempotencyRecord{
+		Key:         key,
+		RequestHash: requestHash,
+		StatusCode:  statusCode,
+		Headers:     headers,
+		Body:        body,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+
+	err := record.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// NewPendingIdempotencyRecord reserves the key for ttl, which should be short
+// so a key is released soon when the server stops in the middle of a request.
+func NewPendingIdempotencyRecord(key string, requestHash string, ttl time.Duration) (*IdempotencyRecord, error) {
+	now := time.Now().UTC()
+	record := &IdempotencyRecord{
+		Key:         key,
+		RequestHash: requestHash,
+		Pending:     true,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+
+	err := record.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (r *IdempotencyRecord) Validate() error {
+	if r.Key == "" {
+		return errors.New("idempotency key is null")
+	}
+	if len(r.Key) > 255 {
+		return errors.New("idempotency key is too long")
+	}
+	if r.RequestHash == "" {
+		return errors.New("request hash is null")
+	}
+	if !r.ExpiresAt.After(r.CreatedAt) {
+		return errors.New("idempotency ttl must be positive")
+	}
+	return nil
+}
+
+func (r *IdempotencyRecord) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+func (r *IdempotencyRecord) Matches(requestHash string) bool {
+	return r.RequestHash == requestHash
+}
+
+type IdempotencyGateway interface {
+	// ReserveIdempotencyKey atomically stores the pending record unless an
+	// unexpired record exists for the key, which is returned instead.
+	ReserveIdempotencyKey(ctx context.Context, record IdempotencyRecord) (*IdempotencyRecord, error)
+	SaveIdempotencyRecord(ctx context.Context, record IdempotencyRecord) error
+	DeleteIdempotencyRecord(ctx context.Context, key string) error
+}
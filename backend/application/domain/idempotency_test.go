@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewIdempotencyRecordSuccess(t *testing.T) {
+	record, err := NewIdempotencyRecord("key-1", "hash", 201, map[string]string{"Location": "/talent/1"}, nil, time.Hour)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if record.ExpiresAt.Sub(record.CreatedAt) != time.Hour {
+		t.Errorf("expected ttl of 1h, got %v", record.ExpiresAt.Sub(record.CreatedAt))
+	}
+
+	if record.IsExpired(record.CreatedAt) {
+		t.Error("expected record not to be expired right after creation")
+	}
+
+	if !record.IsExpired(record.ExpiresAt) {
+		t.Error("expected record to be expired at ExpiresAt")
+	}
+}
+
+func TestNewIdempotencyRecordMissingKey(t *testing.T) {
+	_, err := NewIdempotencyRecord("", "hash", 201, nil, nil, time.Hour)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if err.Error() != "idempotency key is null" {
+		t.Errorf("expected error message 'idempotency key is null', got %s", err.Error())
+	}
+}
+
+func TestNewIdempotencyRecordInvalidTTL(t *testing.T) {
+	_, err := NewIdempotencyRecord("key-1", "hash", 201, nil, nil, 0)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	if err.Error() != "idempotency ttl must be positive" {
+		t.Errorf("expected error message 'idempotency ttl must be positive', got %s", err.Error())
+	}
+}
+
+func TestIdempotencyRecordMatches(t *testing.T) {
+	record, _ := NewIdempotencyRecord("key-1", "hash", 201, nil, nil, time.Hour)
+
+	if !record.Matches("hash") {
+		t.Error("expected record to match the same hash")
+	}
+
+	if record.Matches("other") {
+		t.Error("expected record not to match a different hash")
+	}
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	// the server image is built from scratch, without a zoneinfo database
+	_ "time/tzdata"
+
+	"golang.org/x/text/language"
+)
+
+var ErrInvalidLocation = errors.New("invalid location")
+
+const (
+	WorkModelRemote = "remote"
+	WorkModelHybrid = "hybrid"
+	WorkModelOnSite = "onsite"
+)
+
+var WorkModels = []string{WorkModelRemote, WorkModelHybrid, WorkModelOnSite}
+
+// Location is where a talent lives and how they want to work. Every field is
+// optional; Country is an ISO 3166-1 alpha-2 code and Timezone an IANA name.
+type Location struct {
+	City             string `firestore:"city"`
+	State            string `firestore:"state"`
+	Country          string `firestore:"country"`
+	Timezone         string `firestore:"timezone"`
+	WorkModel        string `firestore:"work_model"`
+	OpenToRelocation bool   `firestore:"open_to_relocation"`
+}
+
+// Normalize trims the fields and fixes the case of the codes, leaving the
+// checks to Validate.
+func (l Location) Normalize() Location {
+	l.City = strings.TrimSpace(l.City)
+	l.State = strings.TrimSpace(l.State)
+	l.Country = strings.ToUpper(strings.TrimSpace(l.Country))
+	l.Timezone = strings.TrimSpace(l.Timezone)
+	l.WorkModel = strings.ToLower(strings.TrimSpace(l.WorkModel))
+	if l.WorkModel == "on-site" || l.WorkModel == "on_site" {
+		l.WorkModel = WorkModelOnSite
+	}
+	return l
+}
+
+func (l Location) Validate() error {
+	if l.Country != "" {
+		region, err := language.ParseRegion(l.Country)
+		if err != nil || len(l.Country) != 2 || !region.IsCountry() {
+			return fmt.Errorf("%w: %q is not an ISO 3166-1 alpha-2 country code", ErrInvalidLocation, l.Country)
+		}
+	}
+	if (l.City != "" || l.State != "") && l.Country == "" {
+		return fmt.Errorf("%w: country is required with city or state", ErrInvalidLocation)
+	}
+	if l.Timezone != "" {
+		_, err := time.LoadLocation(l.Timezone)
+		if err != nil || l.Timezone == "Local" {
+			return fmt.Errorf("%w: %q is not an IANA timezone", ErrInvalidLocation, l.Timezone)
+		}
+	}
+	if l.WorkModel != "" && !slices.Contains(WorkModels, l.WorkModel) {
+		return fmt.Errorf("%w: work model must be one of %s", ErrInvalidLocation, strings.Join(WorkModels, ", "))
+	}
+	return nil
+}
+
+// LocationFilter selects talents by location. Empty fields match anything and
+// text comparisons ignore case and accents, so "Sao Paulo" finds "São Paulo".
+type LocationFilter struct {
+	// Countries matches any of the codes.
+	Countries        []string `firestore:"countries"`
+	State            string   `firestore:"state"`
+	City             string   `firestore:"city"`
+	Timezone         string   `firestore:"timezone"`
+	WorkModel        string   `firestore:"work_model"`
+	OpenToRelocation bool     `firestore:"open_to_relocation"`
+}
+
+func (f LocationFilter) Validate() error {
+	for _, country := range f.Countries {
+		err := Location{Country: strings.ToUpper(country)}.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	return Location{Timezone: f.Timezone, WorkModel: f.WorkModel}.Normalize().Validate()
+}
+
+func (f LocationFilter) Matches(l Location) bool {
+	if len(f.Countries) > 0 && !slices.ContainsFunc(f.Countries, func(country string) bool { return strings.EqualFold(country, l.Country) }) {
+		return false
+	}
+	if f.State != "" && extractorFold(f.State) != extractorFold(l.State) {
+		return false
+	}
+	if f.City != "" && extractorFold(f.City) != extractorFold(l.City) {
+		return false
+	}
+	if f.Timezone != "" && f.Timezone != l.Timezone {
+		return false
+	}
+	if f.WorkModel != "" && (Location{WorkModel: f.WorkModel}).Normalize().WorkModel != l.WorkModel {
+		return false
+	}
+	if f.OpenToRelocation && !l.OpenToRelocation {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSetLocationNormalizes(t *testing.T) {
+	talent, _ := Create("https://linkedin.com/in/test", "Backend Engineer", "John Doe", "Developer", "", "", nil, "")
+	err := talent.SetLocation(Location{City: " São Paulo ", State: "SP", Country: "br", Timezone: "America/Sao_Paulo", WorkModel: "On-Site"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := Location{City: "São Paulo", State: "SP", Country: "BR", Timezone: "America/Sao_Paulo", WorkModel: WorkModelOnSite}
+	if talent.Location != expected {
+		t.Errorf("expected %+v, got %+v", expected, talent.Location)
+	}
+}
+
+func TestLocationValidate(t *testing.T) {
+	tests := map[string]Location{
+		"unknown country":  {Country: "XX"},
+		"alpha-3 country":  {Country: "BRA"},
+		"continent":        {Country: "150"},
+		"city only":        {City: "Lisboa"},
+		"unknown timezone": {Timezone: "America/Atlantis"},
+		"local timezone":   {Timezone: "Local"},
+		"work model":       {WorkModel: "anywhere"},
+	}
+	for name, location := range tests {
+		if err := location.Validate(); !errors.Is(err, ErrInvalidLocation) {
+			t.Errorf("%s: expected ErrInvalidLocation, got %v", name, err)
+		}
+	}
+
+	if err := (Location{City: "Lisboa", Country: "PT", Timezone: "Europe/Lisbon", WorkModel: WorkModelRemote}).Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestLocationFilterMatches(t *testing.T) {
+	location := Location{City: "São Paulo", State: "SP", Country: "BR", WorkModel: WorkModelHybrid, OpenToRelocation: true}
+
+	matching := []LocationFilter{
+		{},
+		{Countries: []string{"pt", "br"}},
+		{City: "sao paulo"},
+		{State: "sp", WorkModel: "Hybrid", OpenToRelocation: true},
+	}
+	for _, filter := range matching {
+		if !filter.Matches(location) {
+			t.Errorf("expected %+v to match", filter)
+		}
+	}
+
+	other := []LocationFilter{
+		{Countries: []string{"PT"}},
+		{City: "Campinas"},
+		{WorkModel: WorkModelRemote},
+		{Timezone: "America/Sao_Paulo"},
+	}
+	for _, filter := range other {
+		if filter.Matches(location) {
+			t.Errorf("expected %+v not to match", filter)
+		}
+	}
+}
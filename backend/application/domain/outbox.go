@@ -0,0 +1,10 @@
+package domain
+
+import "context"
+
+// OutboxGateway reads the events TalentGateway stored next to the talent
+// changes, so they are published even if the process dies right after Save.
+type OutboxGateway interface {
+	GetPendingEvents(ctx context.Context, limit int) ([]TalentEvent, error)
+	MarkPublished(ctx context.Context, eventIds []string) error
+}
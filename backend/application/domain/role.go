@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+var ErrInvalidRoleProfile = errors.New("invalid role profile")
+
+const (
+	FunctionBackend   = "backend"
+	FunctionFrontend  = "frontend"
+	FunctionFullstack = "fullstack"
+	FunctionMobile    = "mobile"
+	FunctionData      = "data"
+	FunctionDevOps    = "devops"
+	FunctionQA        = "qa"
+	FunctionSecurity  = "security"
+	FunctionDesign    = "design"
+	FunctionProduct   = "product"
+	// FunctionSoftware covers generic titles like "Software Engineer".
+	FunctionSoftware = "software"
+)
+
+var Functions = []string{
+	FunctionBackend, FunctionFrontend, FunctionFullstack, FunctionMobile, FunctionData, FunctionDevOps,
+	FunctionQA, FunctionSecurity, FunctionDesign, FunctionProduct, FunctionSoftware,
+}
+
+const (
+	SeniorityJunior = "junior"
+	SeniorityMid    = "mid"
+	SenioritySenior = "senior"
+	SeniorityStaff  = "staff"
+	SeniorityLead   = "lead"
+)
+
+// Seniorities lists the seniority levels from the lowest.
+var Seniorities = []string{SeniorityJunior, SeniorityMid, SenioritySenior, SeniorityStaff, SeniorityLead}
+
+// RoleProfile is the structured form of a free text job title. Empty fields
+// mean the title did not say.
+type RoleProfile struct {
+	Function   string `firestore:"function"`
+	Seniority  string `firestore:"seniority"`
+	Management bool   `firestore:"management"`
+}
+
+type rolePattern struct {
+	value   string
+	phrases []string
+}
+
+// functionPatterns are checked in order, so "full stack" wins over "back end"
+// in "Full Stack (Back End heavy)", explicit functions over the broad domain
+// words in "Backend Engineer | Cloud" and specific functions over generic
+// ones.
+var functionPatterns = []rolePattern{
+	{FunctionFullstack, []string{"full stack", "fullstack"}},
+	{FunctionMobile, []string{"mobile", "android", "ios", "react native", "flutter"}},
+	{FunctionBackend, []string{"backend", "back end", "server side"}},
+	{FunctionFrontend, []string{"frontend", "front end", "web developer", "desenvolvedor web", "desenvolvedora web"}},
+	{FunctionData, []string{"data", "dados", "machine learning", "ml", "ai", "ia", "analytics", "bi", "cientista"}},
+	{FunctionDevOps, []string{"devops", "sre", "site reliability", "infra", "infraestrutura", "infrastructure", "platform", "plataforma", "cloud"}},
+	{FunctionSecurity, []string{"security", "seguranca", "appsec", "infosec", "cybersecurity"}},
+	{FunctionQA, []string{"qa", "quality", "qualidade", "tester", "testes", "test automation"}},
+	{FunctionDesign, []string{"designer", "design", "ux", "ui"}},
+	{FunctionProduct, []string{"product manager", "product owner", "gerente de produto", "dono do produto", "po", "pm", "produto", "product"}},
+	{FunctionSoftware, []string{"software", "developer", "desenvolvedor", "desenvolvedora", "programador", "programadora", "engineer", "engenheiro", "engenheira", "engineering", "dev"}},
+}
+
+// seniorityPatterns are checked from the highest level, so "Senior Staff"
+// is staff. Roman numerals follow the usual engineering ladders.
+var seniorityPatterns = []rolePattern{
+	{SeniorityLead, []string{"lead", "tech lead", "team lead", "lider", "lider tecnico", "lider tecnica"}},
+	{SeniorityStaff, []string{"staff", "principal", "iv", "v"}},
+	{SenioritySenior, []string{"senior", "sr", "iii", "especialista", "specialist"}},
+	{SeniorityMid, []string{"mid", "mid level", "pleno", "plena", "ii", "intermediate"}},
+	{SeniorityJunior, []string{"junior", "jr", "entry level", "trainee", "intern", "estagiario", "estagiaria", "associate"}},
+}
+
+var managementPhrases = []string{
+	"manager", "gerente", "head", "director", "diretor", "diretora", "coordinator", "coordenador", "coordenadora",
+	"supervisor", "supervisora", "cto", "vp", "vice president", "chief",
+}
+
+// nonManagementPhrases are titles with a management word that do not manage
+// people.
+var nonManagementPhrases = []string{"product manager", "project manager", "gerente de produto", "gerente de projetos"}
+
+// NormalizeRole maps a job title, in Portuguese or English, to a RoleProfile.
+func NormalizeRole(title string) RoleProfile {
+	words := foldedWords(title)
+	text := " " + strings.Join(words, " ") + " "
+
+	profile := RoleProfile{
+		Function:  firstPattern(text, functionPatterns),
+		Seniority: firstPattern(text, seniorityPatterns),
+	}
+	// "Engineer I" only, a lone "i" elsewhere is too ambiguous
+	if profile.Seniority == "" && len(words) > 1 && words[len(words)-1] == "i" {
+		profile.Seniority = SeniorityJunior
+	}
+
+	for _, phrase := range nonManagementPhrases {
+		text = strings.ReplaceAll(text, " "+phrase+" ", " ")
+	}
+	profile.Management = slices.ContainsFunc(managementPhrases, func(phrase string) bool {
+		return strings.Contains(text, " "+phrase+" ")
+	})
+	return profile
+}
+
+// NormalizeRoles fills each field from the first title that has it, so the
+// target role wins and the current one completes it.
+func NormalizeRoles(titles ...string) RoleProfile {
+	var profile RoleProfile
+	for _, title := range titles {
+		p := NormalizeRole(title)
+		if profile.Function == "" {
+			profile.Function = p.Function
+		}
+		if profile.Seniority == "" {
+			profile.Seniority = p.Seniority
+		}
+		profile.Management = profile.Management || p.Management
+	}
+	return profile
+}
+
+// seniorityOf returns the level a phrase such as "Sênior" or "Tech Lead"
+// names on its own, empty when it names none.
+func seniorityOf(phrase string) string {
+	folded := strings.Join(foldedWords(phrase), " ")
+	for _, pattern := range seniorityPatterns {
+		if folded == pattern.value || slices.Contains(pattern.phrases, folded) {
+			return pattern.value
+		}
+	}
+	return ""
+}
+
+func foldedWords(text string) []string {
+	words := extractorWords(text)
+	for i, word := range words {
+		words[i] = extractorFold(word)
+	}
+	return words
+}
+
+func firstPattern(text string, patterns []rolePattern) string {
+	for _, pattern := range patterns {
+		for _, phrase := range pattern.phrases {
+			if strings.Contains(text, " "+phrase+" ") {
+				return pattern.value
+			}
+		}
+	}
+	return ""
+}
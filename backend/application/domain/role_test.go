@@ -0,0 +1,58 @@
+package domain
+
+import "testing"
+
+func TestNormalizeRole(t *testing.T) {
+	tests := map[string]RoleProfile{
+		"Sr. Dev Backend":                   {Function: FunctionBackend, Seniority: SenioritySenior},
+		"Engenheiro de Software III":        {Function: FunctionSoftware, Seniority: SenioritySenior},
+		"Software Engineer I":               {Function: FunctionSoftware, Seniority: SeniorityJunior},
+		"Desenvolvedora Front-End Pleno":    {Function: FunctionFrontend, Seniority: SeniorityMid},
+		"Full-Stack Developer (Back End)":   {Function: FunctionFullstack},
+		"Cientista de Dados Júnior":         {Function: FunctionData, Seniority: SeniorityJunior},
+		"Staff Site Reliability Engineer":   {Function: FunctionDevOps, Seniority: SeniorityStaff},
+		"Tech Lead | Payments":              {Seniority: SeniorityLead},
+		"Engineering Manager":               {Function: FunctionSoftware, Management: true},
+		"Gerente de Engenharia de Software": {Function: FunctionSoftware, Management: true},
+		"Senior Product Manager":            {Function: FunctionProduct, Seniority: SenioritySenior},
+		"Product Designer":                  {Function: FunctionDesign},
+		"Head of Data":                      {Function: FunctionData, Management: true},
+		"Analista de QA Sênior":             {Function: FunctionQA, Seniority: SenioritySenior},
+		"Desenvolvedor iOS":                 {Function: FunctionMobile},
+		"Backend Engineer | Cloud":          {Function: FunctionBackend},
+		"Desenvolvedor Backend – Dados":     {Function: FunctionBackend},
+		"Frontend Developer - AI Platform":  {Function: FunctionFrontend},
+		"Platform Engineer":                 {Function: FunctionDevOps},
+		"Recruiter":                         {},
+	}
+	for title, expected := range tests {
+		if got := NormalizeRole(title); got != expected {
+			t.Errorf("%q: expected %+v, got %+v", title, expected, got)
+		}
+	}
+}
+
+func TestNormalizeRolesCompletesFromCurrentRole(t *testing.T) {
+	got := NormalizeRoles("Backend Engineer", "Sr. Software Engineer")
+	expected := RoleProfile{Function: FunctionBackend, Seniority: SenioritySenior}
+	if got != expected {
+		t.Errorf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestTalentKeepsRoleNormalized(t *testing.T) {
+	talent, _ := Create("https://linkedin.com/in/test", "Desenvolvedor Backend Sênior", "John Doe", "Developer", "", "", nil, "")
+	if talent.Role.Function != FunctionBackend || talent.Role.Seniority != SenioritySenior {
+		t.Errorf("unexpected role %+v", talent.Role)
+	}
+
+	_ = talent.Update(talent.ProfileURL, "Data Engineer", talent.FullName, talent.Headline, "", "Pleno", nil, "")
+	if talent.Role.Function != FunctionData || talent.Role.Seniority != SeniorityMid {
+		t.Errorf("expected the role to follow the update, got %+v", talent.Role)
+	}
+
+	legacy := Talent{PossibleRole: "Frontend Engineer"}
+	if legacy.NormalizedRole().Function != FunctionFrontend {
+		t.Errorf("expected legacy talents to be normalized on read, got %+v", legacy.NormalizedRole())
+	}
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSavedSearchNotFound = errors.New("saved search not found")
+	ErrInvalidSavedSearch  = errors.New("invalid saved search")
+)
+
+const maxSavedSearchName = 100
+
+// SavedSearch keeps a talent list filter to be run again later. Shared
+// searches are visible to everyone, the others only to their owner.
+type SavedSearch struct {
+	Id        uuid.UUID    `firestore:"-"`
+	Name      string       `firestore:"name"`
+	Owner     string       `firestore:"owner"`
+	Shared    bool         `firestore:"shared"`
+	Filter    TalentFilter `firestore:"filter"`
+	CreatedAt time.Time    `firestore:"created_at"`
+}
+
+func NewSavedSearch(name string, owner string, shared bool, filter TalentFilter) (*SavedSearch, error) {
+	search := &SavedSearch{
+		Id:        uuid.New(),
+		Name:      strings.TrimSpace(name),
+		Owner:     strings.TrimSpace(owner),
+		Shared:    shared,
+		Filter:    filter.Normalize(),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err := search.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return search, nil
+}
+
+func (s *SavedSearch) Validate() error {
+	err := validateListName(s.Name, s.Owner)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSavedSearch, err)
+	}
+	return s.Filter.Validate()
+}
+
+// VisibleTo reports whether the owner can see and run the search. An empty
+// owner sees only shared searches.
+func (s *SavedSearch) VisibleTo(owner string) bool {
+	return s.Shared || (owner != "" && s.Owner == owner)
+}
+
+func validateListName(name string, owner string) error {
+	if name == "" {
+		return errors.New("name is null")
+	}
+	if len([]rune(name)) > maxSavedSearchName {
+		return fmt.Errorf("name must have at most %d characters", maxSavedSearchName)
+	}
+	if owner == "" {
+		return errors.New("owner is null")
+	}
+	return nil
+}
+
+type SavedSearchGateway interface {
+	SaveSavedSearch(ctx context.Context, search SavedSearch) error
+	GetSavedSearchById(ctx context.Context, id string) (*SavedSearch, error)
+	// GetSavedSearches returns the searches of the owner and the shared ones,
+	// ordered by name.
+	GetSavedSearches(ctx context.Context, owner string) ([]SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, id string) error
+}
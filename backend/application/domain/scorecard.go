@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidScorecard = errors.New("invalid scorecard")
+
+const (
+	RecommendationStrongNo  = "strong_no"
+	RecommendationNo        = "no"
+	RecommendationYes       = "yes"
+	RecommendationStrongYes = "strong_yes"
+)
+
+var Recommendations = []string{RecommendationStrongNo, RecommendationNo, RecommendationYes, RecommendationStrongYes}
+
+const (
+	MinScore              = 1
+	MaxScore              = 5
+	maxScorecardCriteria  = 20
+	maxCriterionName      = 60
+	maxScorecardComment   = 5000
+	maxScorecardEvaluator = 100
+)
+
+type CriterionScore struct {
+	Name  string `firestore:"name"`
+	Score int    `firestore:"score"`
+}
+
+// Scorecard is the evaluation of a talent by one evaluator after a call,
+// optionally for an opening.
+type Scorecard struct {
+	Id             uuid.UUID        `firestore:"-"`
+	TalentId       string           `firestore:"talent_id"`
+	OpeningId      string           `firestore:"opening_id"`
+	Evaluator      string           `firestore:"evaluator"`
+	Criteria       []CriterionScore `firestore:"criteria"`
+	Recommendation string           `firestore:"recommendation"`
+	Comment        string           `firestore:"comment"`
+	CreatedAt      time.Time        `firestore:"created_at"`
+}
+
+func NewScorecard(talentId string, openingId string, evaluator string, criteria []CriterionScore, recommendation string, comment string) (*Scorecard, error) {
+	scorecard := &Scorecard{
+		Id:             uuid.New(),
+		TalentId:       talentId,
+		OpeningId:      strings.TrimSpace(openingId),
+		Evaluator:      strings.TrimSpace(evaluator),
+		Recommendation: strings.ToLower(strings.TrimSpace(recommendation)),
+		Comment:        strings.TrimSpace(comment),
+		CreatedAt:      time.Now().UTC(),
+	}
+	for _, criterion := range criteria {
+		criterion.Name = strings.Join(strings.Fields(criterion.Name), " ")
+		scorecard.Criteria = append(scorecard.Criteria, criterion)
+	}
+
+	err := scorecard.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return scorecard, nil
+}
+
+func (s *Scorecard) Validate() error {
+	if s.TalentId == "" {
+		return fmt.Errorf("%w: talent is null", ErrInvalidScorecard)
+	}
+	if s.Evaluator == "" {
+		return fmt.Errorf("%w: evaluator is null", ErrInvalidScorecard)
+	}
+	if len([]rune(s.Evaluator)) > maxScorecardEvaluator {
+		return fmt.Errorf("%w: evaluator must have at most %d characters", ErrInvalidScorecard, maxScorecardEvaluator)
+	}
+	if len(s.Criteria) == 0 || len(s.Criteria) > maxScorecardCriteria {
+		return fmt.Errorf("%w: between 1 and %d criteria are required", ErrInvalidScorecard, maxScorecardCriteria)
+	}
+	seen := make(map[string]bool, len(s.Criteria))
+	for _, criterion := range s.Criteria {
+		if criterion.Name == "" || len([]rune(criterion.Name)) > maxCriterionName {
+			return fmt.Errorf("%w: criterion name must have between 1 and %d characters", ErrInvalidScorecard, maxCriterionName)
+		}
+		if seen[strings.ToLower(criterion.Name)] {
+			return fmt.Errorf("%w: criterion %q is repeated", ErrInvalidScorecard, criterion.Name)
+		}
+		seen[strings.ToLower(criterion.Name)] = true
+		if criterion.Score < MinScore || criterion.Score > MaxScore {
+			return fmt.Errorf("%w: score of %q must be between %d and %d", ErrInvalidScorecard, criterion.Name, MinScore, MaxScore)
+		}
+	}
+	if !slices.Contains(Recommendations, s.Recommendation) {
+		return fmt.Errorf("%w: recommendation must be one of %s", ErrInvalidScorecard, strings.Join(Recommendations, ", "))
+	}
+	if len([]rune(s.Comment)) > maxScorecardComment {
+		return fmt.Errorf("%w: comment must have at most %d characters", ErrInvalidScorecard, maxScorecardComment)
+	}
+	return nil
+}
+
+// Average is the mean of the criterion scores.
+func (s *Scorecard) Average() float64 {
+	total := 0
+	for _, criterion := range s.Criteria {
+		total += criterion.Score
+	}
+	return float64(total) / float64(len(s.Criteria))
+}
+
+type CriterionSummary struct {
+	Name    string  `firestore:"name"`
+	Average float64 `firestore:"average"`
+	Count   int     `firestore:"count"`
+}
+
+// ScorecardSummary aggregates the scorecards of a talent. Average weighs
+// every scorecard equally, whatever its number of criteria.
+type ScorecardSummary struct {
+	Count           int                `firestore:"count"`
+	Average         float64            `firestore:"average"`
+	Criteria        []CriterionSummary `firestore:"criteria"`
+	Recommendations map[string]int     `firestore:"recommendations"`
+}
+
+// SummarizeScorecards groups criteria by name ignoring case, keeping the
+// name of the first scorecard, and orders them by name. Averages are
+// rounded to two decimals.
+func SummarizeScorecards(scorecards []Scorecard) ScorecardSummary {
+	if len(scorecards) == 0 {
+		return ScorecardSummary{}
+	}
+
+	summary := ScorecardSummary{Count: len(scorecards), Recommendations: make(map[string]int)}
+	type total struct {
+		name  string
+		sum   int
+		count int
+	}
+	totals := make(map[string]*total)
+	average := 0.0
+	for _, scorecard := range scorecards {
+		average += scorecard.Average()
+		summary.Recommendations[scorecard.Recommendation]++
+		for _, criterion := range scorecard.Criteria {
+			key := strings.ToLower(criterion.Name)
+			if totals[key] == nil {
+				totals[key] = &total{name: criterion.Name}
+			}
+			totals[key].sum += criterion.Score
+			totals[key].count++
+		}
+	}
+	summary.Average = roundScore(average / float64(len(scorecards)))
+	for _, t := range totals {
+		summary.Criteria = append(summary.Criteria, CriterionSummary{
+			Name:    t.name,
+			Average: roundScore(float64(t.sum) / float64(t.count)),
+			Count:   t.count,
+		})
+	}
+	slices.SortFunc(summary.Criteria, func(a, b CriterionSummary) int {
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	return summary
+}
+
+// SetScorecards reports whether the summary changed.
+func (t *Talent) SetScorecards(summary ScorecardSummary) bool {
+	if reflect.DeepEqual(t.Scorecards, summary) {
+		return false
+	}
+	t.Scorecards = summary
+	return true
+}
+
+func roundScore(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+type ScorecardGateway interface {
+	SaveScorecard(ctx context.Context, scorecard Scorecard) error
+	// GetScorecards returns the scorecards of the talent, oldest first, only
+	// the ones of the opening when it is not empty.
+	GetScorecards(ctx context.Context, talentId string, openingId string) ([]Scorecard, error)
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+)
+
+func TestSummarizeScorecards(t *testing.T) {
+	scorecards := []Scorecard{
+		{Criteria: []CriterionScore{{"Go", 4}, {"Communication", 3}}, Recommendation: RecommendationYes},
+		{Criteria: []CriterionScore{{"go", 5}}, Recommendation: RecommendationStrongYes},
+		{Criteria: []CriterionScore{{"Go", 2}, {"Communication", 2}, {"Testing", 3}}, Recommendation: RecommendationNo},
+	}
+
+	summary := SummarizeScorecards(scorecards)
+
+	// (3.5 + 5 + 2.33) / 3
+	if summary.Count != 3 || summary.Average != 3.61 {
+		t.Errorf("expected 3 scorecards averaging 3.61, got %+v", summary)
+	}
+	want := []CriterionSummary{{"Communication", 2.5, 2}, {"Go", 3.67, 3}, {"Testing", 3, 1}}
+	if len(summary.Criteria) != len(want) {
+		t.Fatalf("expected %v, got %v", want, summary.Criteria)
+	}
+	for i := range want {
+		if summary.Criteria[i] != want[i] {
+			t.Errorf("expected %v, got %v", want[i], summary.Criteria[i])
+		}
+	}
+	if summary.Recommendations[RecommendationYes] != 1 || summary.Recommendations[RecommendationNo] != 1 {
+		t.Errorf("unexpected recommendations %v", summary.Recommendations)
+	}
+
+	if empty := SummarizeScorecards(nil); empty.Count != 0 || empty.Criteria != nil {
+		t.Errorf("expected an empty summary, got %+v", empty)
+	}
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// MaxCVKeywords bounds the keywords kept per talent, well below the
+// Firestore limits on array size and index entries.
+const MaxCVKeywords = 2000
+
+// searchStopWords are left out of the keywords as they would match almost
+// every CV, in Portuguese and English.
+var searchStopWords = []string{
+	"a", "ao", "as", "com", "da", "das", "de", "do", "dos", "e", "em", "na", "nas", "no", "nos", "o", "os", "ou", "para",
+	"pela", "pelo", "por", "que", "se", "um", "uma", "an", "and", "at", "by", "for", "from", "in", "is", "of", "on",
+	"or", "the", "to", "with",
+}
+
+// SearchKeywords returns the distinct words of text, without accents and in
+// lower case, in order of appearance. Numbers and stop words are left out.
+// A limit of zero keeps every word.
+func SearchKeywords(text string, limit int) []string {
+	var keywords []string
+	for _, word := range extractorWords(text) {
+		word = extractorFold(word)
+		if !strings.ContainsFunc(word, unicode.IsLetter) || slices.Contains(searchStopWords, word) {
+			continue
+		}
+		if slices.Contains(keywords, word) {
+			continue
+		}
+		keywords = append(keywords, word)
+		if limit > 0 && len(keywords) == limit {
+			break
+		}
+	}
+	return keywords
+}
+
+// MatchesSearch reports whether every term, as returned by SearchKeywords,
+// starts a word of the talent's fields or CV keywords, so "kube" finds
+// "Kubernetes".
+func (t *Talent) MatchesSearch(terms []string) bool {
+	if len(terms) == 0 {
+		return true
+	}
+	words := SearchKeywords(strings.Join(append([]string{t.FullName, t.Headline, t.PossibleRole, t.CurrentRole,
+		t.CurrentCompany, t.Notes}, t.Tags...), " "), 0)
+	words = append(words, t.CVKeywords...)
+	for _, term := range terms {
+		if !slices.ContainsFunc(words, func(word string) bool { return strings.HasPrefix(word, term) }) {
+			return false
+		}
+	}
+	return true
+}
+
+// AddCVKeywords merges keywords read from an attachment into the ones used
+// by search, up to MaxCVKeywords, and reports whether any was added. No
+// event is recorded as nothing shown about the talent changes.
+func (t *Talent) AddCVKeywords(keywords []string) bool {
+	added := false
+	for _, keyword := range keywords {
+		if len(t.CVKeywords) == MaxCVKeywords {
+			break
+		}
+		if !slices.Contains(t.CVKeywords, keyword) {
+			t.CVKeywords = append(t.CVKeywords, keyword)
+			added = true
+		}
+	}
+	return added
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestSearchKeywords(t *testing.T) {
+	got := SearchKeywords("Engenheira de Dados | Python, PYTHON e Análise de dados em 2024", 0)
+	want := []string{"engenheira", "dados", "python", "analise"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got = SearchKeywords("go rust java", 2); !slices.Equal(got, []string{"go", "rust"}) {
+		t.Errorf("expected the limit to apply, got %v", got)
+	}
+}
+
+func TestTalentMatchesSearch(t *testing.T) {
+	talent, _ := Create("https://linkedin.com/in/ana", "Data Engineer", "Ana Souza", "Dados na Acme", "Acme", "Data Engineer",
+		[]string{"Python"}, "")
+	talent.AddCVKeywords([]string{"airflow", "kubernetes"})
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"", true},
+		{"souza", true},
+		{"PYTH", true},
+		{"kube airflow", true},
+		{"análise", false},
+		{"airflow rust", false},
+	}
+	for _, tt := range tests {
+		if got := talent.MatchesSearch(SearchKeywords(tt.query, 0)); got != tt.want {
+			t.Errorf("%q: expected %v, got %v", tt.query, tt.want, got)
+		}
+	}
+}
+
+func TestAddCVKeywordsIsBounded(t *testing.T) {
+	talent := &Talent{}
+	keywords := make([]string, 0, MaxCVKeywords+10)
+	for i := range MaxCVKeywords + 10 {
+		keywords = append(keywords, fmt.Sprintf("keyword%d", i))
+	}
+	if !talent.AddCVKeywords(keywords) || len(talent.CVKeywords) != MaxCVKeywords {
+		t.Errorf("expected %d keywords, got %d", MaxCVKeywords, len(talent.CVKeywords))
+	}
+	if talent.AddCVKeywords(keywords[:5]) {
+		t.Error("expected known keywords not to change the talent")
+	}
+}
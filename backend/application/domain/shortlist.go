@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrShortlistNotFound = errors.New("shortlist not found")
+	ErrInvalidShortlist  = errors.New("invalid shortlist")
+	// ErrShortlistConflict is returned when the shortlist changed since it
+	// was read.
+	ErrShortlistConflict = errors.New("shortlist version conflict")
+)
+
+const MaxShortlistTalents = 200
+
+// Shortlist is a manual, ordered list of talents.
+type Shortlist struct {
+	Id        uuid.UUID `firestore:"-"`
+	Name      string    `firestore:"name"`
+	Owner     string    `firestore:"owner"`
+	Shared    bool      `firestore:"shared"`
+	TalentIds []string  `firestore:"talent_ids"`
+	CreatedAt time.Time `firestore:"created_at"`
+	UpdatedAt time.Time `firestore:"updated_at"`
+	Version   int64     `firestore:"version"`
+}
+
+func NewShortlist(name string, owner string, shared bool) (*Shortlist, error) {
+	now := time.Now().UTC()
+	shortlist := &Shortlist{
+		Id:        uuid.New(),
+		Name:      strings.TrimSpace(name),
+		Owner:     strings.TrimSpace(owner),
+		Shared:    shared,
+		TalentIds: []string{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err := shortlist.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return shortlist, nil
+}
+
+func (s *Shortlist) Validate() error {
+	err := validateListName(s.Name, s.Owner)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidShortlist, err)
+	}
+	if len(s.TalentIds) > MaxShortlistTalents {
+		return fmt.Errorf("%w: at most %d talents", ErrInvalidShortlist, MaxShortlistTalents)
+	}
+	return nil
+}
+
+// VisibleTo reports whether the owner can see the shortlist. An empty owner
+// sees only shared shortlists.
+func (s *Shortlist) VisibleTo(owner string) bool {
+	return s.Shared || (owner != "" && s.Owner == owner)
+}
+
+// Add inserts the talent at the zero based position, or at the end when the
+// position is negative or past the end. It reports whether the list changed,
+// talents already in the list are kept where they are.
+func (s *Shortlist) Add(talentId string, position int, now time.Time) (bool, error) {
+	if talentId == "" {
+		return false, fmt.Errorf("%w: talent is null", ErrInvalidShortlist)
+	}
+	if slices.Contains(s.TalentIds, talentId) {
+		return false, nil
+	}
+	if len(s.TalentIds) >= MaxShortlistTalents {
+		return false, fmt.Errorf("%w: at most %d talents", ErrInvalidShortlist, MaxShortlistTalents)
+	}
+	if position < 0 || position > len(s.TalentIds) {
+		position = len(s.TalentIds)
+	}
+	s.TalentIds = slices.Insert(s.TalentIds, position, talentId)
+	s.UpdatedAt = now.UTC()
+	return true, nil
+}
+
+// Remove reports whether the talent was in the list.
+func (s *Shortlist) Remove(talentId string, now time.Time) bool {
+	i := slices.Index(s.TalentIds, talentId)
+	if i < 0 {
+		return false
+	}
+	s.TalentIds = slices.Delete(s.TalentIds, i, i+1)
+	s.UpdatedAt = now.UTC()
+	return true
+}
+
+// Reorder replaces the order of the talents. The new order must have exactly
+// the talents already in the list.
+func (s *Shortlist) Reorder(talentIds []string, now time.Time) error {
+	if len(talentIds) != len(s.TalentIds) {
+		return fmt.Errorf("%w: the order must list the %d talents of the shortlist", ErrInvalidShortlist, len(s.TalentIds))
+	}
+	seen := make(map[string]bool, len(talentIds))
+	for _, id := range talentIds {
+		if seen[id] || !slices.Contains(s.TalentIds, id) {
+			return fmt.Errorf("%w: the order must list the %d talents of the shortlist", ErrInvalidShortlist, len(s.TalentIds))
+		}
+		seen[id] = true
+	}
+	s.TalentIds = slices.Clone(talentIds)
+	s.UpdatedAt = now.UTC()
+	return nil
+}
+
+type ShortlistGateway interface {
+	// SaveShortlist stores the shortlist when its version matches the stored
+	// one, incrementing it, and returns ErrShortlistConflict otherwise.
+	SaveShortlist(ctx context.Context, shortlist *Shortlist) error
+	GetShortlistById(ctx context.Context, id string) (*Shortlist, error)
+	// GetShortlists returns the shortlists of the owner and the shared ones,
+	// ordered by name.
+	GetShortlists(ctx context.Context, owner string) ([]Shortlist, error)
+	DeleteShortlist(ctx context.Context, id string) error
+}
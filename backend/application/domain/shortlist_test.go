@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestShortlistAddRemoveReorder(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	shortlist, err := NewShortlist(" Backend finalists ", "ana", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, id := range []string{"a", "b"} {
+		_, _ = shortlist.Add(id, -1, now)
+	}
+	changed, _ := shortlist.Add("c", 1, now)
+	if !changed || !slices.Equal(shortlist.TalentIds, []string{"a", "c", "b"}) {
+		t.Fatalf("expected c in the middle, got %v", shortlist.TalentIds)
+	}
+	if changed, _ := shortlist.Add("b", 0, now); changed {
+		t.Error("expected a talent already in the list to stay where it is")
+	}
+
+	err = shortlist.Reorder([]string{"b", "a", "c"}, now)
+	if err != nil || !slices.Equal(shortlist.TalentIds, []string{"b", "a", "c"}) {
+		t.Fatalf("expected the new order, got %v %v", shortlist.TalentIds, err)
+	}
+	for _, order := range [][]string{{"b", "a"}, {"b", "a", "a"}, {"b", "a", "d"}} {
+		if err := shortlist.Reorder(order, now); !errors.Is(err, ErrInvalidShortlist) {
+			t.Errorf("expected ErrInvalidShortlist for %v, got %v", order, err)
+		}
+	}
+
+	if !shortlist.Remove("a", now) || shortlist.Remove("a", now) {
+		t.Error("expected a to be removed once")
+	}
+	if !slices.Equal(shortlist.TalentIds, []string{"b", "c"}) {
+		t.Errorf("expected b and c, got %v", shortlist.TalentIds)
+	}
+}
+
+func TestShortlistLimit(t *testing.T) {
+	shortlist, _ := NewShortlist("Long", "ana", false)
+	for i := range MaxShortlistTalents {
+		_, _ = shortlist.Add(strings.Repeat("x", i+1), -1, time.Now())
+	}
+	_, err := shortlist.Add("one more", -1, time.Now())
+	if !errors.Is(err, ErrInvalidShortlist) {
+		t.Errorf("expected ErrInvalidShortlist past the limit, got %v", err)
+	}
+}
+
+func TestNewSavedSearchInvalid(t *testing.T) {
+	cases := map[string]struct {
+		name   string
+		owner  string
+		filter TalentFilter
+		want   error
+	}{
+		"missing name":  {" ", "ana", TalentFilter{}, ErrInvalidSavedSearch},
+		"long name":     {strings.Repeat("a", 101), "ana", TalentFilter{}, ErrInvalidSavedSearch},
+		"missing owner": {"Backend", "", TalentFilter{}, ErrInvalidSavedSearch},
+		"invalid stage": {"Backend", "ana", TalentFilter{Stage: "called"}, ErrInvalidStage},
+	}
+	for name, c := range cases {
+		_, err := NewSavedSearch(c.name, c.owner, false, c.filter)
+		if !errors.Is(err, c.want) {
+			t.Errorf("%s: expected %v, got %v", name, c.want, err)
+		}
+	}
+}
+
+func TestTalentFilterMatchesTags(t *testing.T) {
+	talent, _ := Create("https://linkedin.com/in/ana", "Backend Engineer", "Ana Souza", "Go", "Acme", "Backend Engineer", []string{"Golang", "Kubernetes"}, "")
+	filter := TalentFilter{Tags: []string{" golang ", "kubernetes"}}.Normalize()
+	if !filter.Matches(*talent) {
+		t.Error("expected the tags to match ignoring case")
+	}
+	filter = TalentFilter{Tags: []string{"golang", "rust"}}.Normalize()
+	if filter.Matches(*talent) {
+		t.Error("expected every tag to be required")
+	}
+}
@@ -0,0 +1,233 @@
+package domain
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+var ErrInvalidStatsQuery = errors.New("invalid stats query")
+
+const (
+	BucketDay   = "day"
+	BucketWeek  = "week"
+	BucketMonth = "month"
+
+	// MaxStatsBuckets keeps a day bucketed query to about one year.
+	MaxStatsBuckets = 366
+)
+
+// StatsQuery covers talents captured in [From, To).
+type StatsQuery struct {
+	Bucket string
+	From   time.Time
+	To     time.Time
+	TopN   int
+}
+
+func (q StatsQuery) Validate() error {
+	if !slices.Contains([]string{BucketDay, BucketWeek, BucketMonth}, q.Bucket) {
+		return fmt.Errorf("%w: bucket must be day, week or month", ErrInvalidStatsQuery)
+	}
+	if !q.From.Before(q.To) {
+		return fmt.Errorf("%w: from must be before to", ErrInvalidStatsQuery)
+	}
+	if len(q.Buckets()) > MaxStatsBuckets {
+		return fmt.Errorf("%w: too many buckets, use a larger bucket or a shorter range", ErrInvalidStatsQuery)
+	}
+	if q.TopN <= 0 {
+		return fmt.Errorf("%w: top must be positive", ErrInvalidStatsQuery)
+	}
+	return nil
+}
+
+// BucketStart truncates t to the start of its bucket in UTC. Weeks start on
+// Monday.
+func BucketStart(t time.Time, bucket string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch bucket {
+	case BucketWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case BucketMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+func nextBucket(start time.Time, bucket string) time.Time {
+	switch bucket {
+	case BucketWeek:
+		return start.AddDate(0, 0, 7)
+	case BucketMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// Buckets returns the [Start, End) ranges covering the query, the first and
+// last ones clipped to From and To.
+func (q StatsQuery) Buckets() []TimeBucket {
+	var buckets []TimeBucket
+	for start := BucketStart(q.From, q.Bucket); start.Before(q.To); start = nextBucket(start, q.Bucket) {
+		bucket := TimeBucket{Start: start, End: nextBucket(start, q.Bucket)}
+		if bucket.Start.Before(q.From) {
+			bucket.Start = q.From
+		}
+		if bucket.End.After(q.To) {
+			bucket.End = q.To
+		}
+		buckets = append(buckets, bucket)
+		if len(buckets) > MaxStatsBuckets {
+			break
+		}
+	}
+	return buckets
+}
+
+type TimeBucket struct {
+	Start time.Time
+	End   time.Time
+	Count int64
+}
+
+type NamedCount struct {
+	Name  string
+	Count int64
+}
+
+// Stats covers every talent captured in the range, archived ones included:
+// an archived talent still counts in the stage it stopped at.
+type Stats struct {
+	Total        int64
+	Captured     []TimeBucket
+	TopTags      []NamedCount
+	TopCompanies []NamedCount
+	TopRoles     []NamedCount
+	// Funnel has one entry per stage, in Stages order.
+	Funnel []NamedCount
+}
+
+type StatsGateway interface {
+	GetStats(ctx context.Context, query StatsQuery) (*Stats, error)
+}
+
+// StatsAccumulator computes Stats in memory, one talent at a time. Gateways
+// without aggregation support use it for everything, the others for the
+// top-N lists.
+type StatsAccumulator struct {
+	query     StatsQuery
+	total     int64
+	buckets   []TimeBucket
+	tags      *counter
+	companies *counter
+	roles     *counter
+	stages    map[string]int64
+}
+
+func NewStatsAccumulator(query StatsQuery) *StatsAccumulator {
+	return &StatsAccumulator{
+		query:     query,
+		buckets:   query.Buckets(),
+		tags:      newCounter(),
+		companies: newCounter(),
+		roles:     newCounter(),
+		stages:    make(map[string]int64),
+	}
+}
+
+// Add counts the talent if it was captured inside the query range.
+func (a *StatsAccumulator) Add(t Talent) {
+	if t.CapturedAt.Before(a.query.From) || !t.CapturedAt.Before(a.query.To) {
+		return
+	}
+	a.total++
+	if i := sort.Search(len(a.buckets), func(i int) bool {
+		return a.buckets[i].End.After(t.CapturedAt)
+	}); i < len(a.buckets) {
+		a.buckets[i].Count++
+	}
+	a.AddDimensions(t)
+	a.stages[t.CurrentStage()]++
+}
+
+// AddDimensions only counts tags, company and role, for gateways that get
+// the totals from aggregation queries.
+func (a *StatsAccumulator) AddDimensions(t Talent) {
+	for _, tag := range t.Tags {
+		a.tags.add(tag)
+	}
+	a.companies.add(t.CurrentCompany)
+	a.roles.add(t.PossibleRole)
+}
+
+func (a *StatsAccumulator) Result() *Stats {
+	stats := &Stats{
+		Total:        a.total,
+		Captured:     a.buckets,
+		TopTags:      a.tags.top(a.query.TopN),
+		TopCompanies: a.companies.top(a.query.TopN),
+		TopRoles:     a.roles.top(a.query.TopN),
+	}
+	for _, stage := range Stages {
+		stats.Funnel = append(stats.Funnel, NamedCount{Name: stage, Count: a.stages[stage]})
+	}
+	return stats
+}
+
+// counter groups values case-insensitively and reports the most common
+// spelling of each.
+type counter struct {
+	counts    map[string]int64
+	spellings map[string]map[string]int64
+}
+
+func newCounter() *counter {
+	return &counter{
+		counts:    make(map[string]int64),
+		spellings: make(map[string]map[string]int64),
+	}
+}
+
+func (c *counter) add(value string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return
+	}
+	key := strings.ToLower(value)
+	c.counts[key]++
+	if c.spellings[key] == nil {
+		c.spellings[key] = make(map[string]int64)
+	}
+	c.spellings[key][value]++
+}
+
+func (c *counter) top(n int) []NamedCount {
+	counts := make([]NamedCount, 0, len(c.counts))
+	for key, count := range c.counts {
+		counts = append(counts, NamedCount{Name: c.spelling(key), Count: count})
+	}
+	slices.SortFunc(counts, func(a, b NamedCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Name, b.Name))
+	})
+	return counts[:min(n, len(counts))]
+}
+
+func (c *counter) spelling(key string) string {
+	var best string
+	var bestCount int64
+	for value, count := range c.spellings[key] {
+		if count > bestCount || (count == bestCount && value < best) {
+			best, bestCount = value, count
+		}
+	}
+	return best
+}
@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBucketStart(t *testing.T) {
+	// a Wednesday
+	at := time.Date(2024, 5, 15, 18, 30, 0, 0, time.UTC)
+
+	tests := map[string]time.Time{
+		BucketDay:   time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC),
+		BucketWeek:  time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC),
+		BucketMonth: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for bucket, expected := range tests {
+		if got := BucketStart(at, bucket); !got.Equal(expected) {
+			t.Errorf("%s: expected %v, got %v", bucket, expected, got)
+		}
+	}
+}
+
+func TestStatsQueryValidate(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []StatsQuery{
+		{Bucket: "year", From: from, To: from.AddDate(0, 1, 0), TopN: 10},
+		{Bucket: BucketDay, From: from, To: from, TopN: 10},
+		{Bucket: BucketDay, From: from, To: from.AddDate(2, 0, 0), TopN: 10},
+		{Bucket: BucketDay, From: from, To: from.AddDate(0, 1, 0)},
+	}
+	for _, query := range tests {
+		if err := query.Validate(); !errors.Is(err, ErrInvalidStatsQuery) {
+			t.Errorf("expected ErrInvalidStatsQuery for %+v, got %v", query, err)
+		}
+	}
+
+	valid := StatsQuery{Bucket: BucketMonth, From: from, To: from.AddDate(2, 0, 0), TopN: 10}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestStatsAccumulator(t *testing.T) {
+	query := StatsQuery{
+		Bucket: BucketWeek,
+		From:   time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(2024, 5, 29, 0, 0, 0, 0, time.UTC),
+		TopN:   2,
+	}
+	accumulator := NewStatsAccumulator(query)
+	talents := []Talent{
+		{CapturedAt: time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC), Tags: []string{"Golang", "aws"}, CurrentCompany: "Acme", PossibleRole: "Backend"},
+		{CapturedAt: time.Date(2024, 5, 20, 10, 0, 0, 0, time.UTC), Tags: []string{"golang"}, CurrentCompany: "acme", PossibleRole: "Backend", Stage: StageInterviewing},
+		{CapturedAt: time.Date(2024, 5, 28, 10, 0, 0, 0, time.UTC), Tags: []string{"golang", "react"}, CurrentCompany: "Globex", PossibleRole: "Frontend", Stage: StageHired},
+		// outside the range
+		{CapturedAt: time.Date(2024, 5, 29, 0, 0, 0, 0, time.UTC), Tags: []string{"react"}},
+		{CapturedAt: time.Date(2024, 5, 14, 23, 0, 0, 0, time.UTC), Tags: []string{"react"}},
+	}
+	for _, talent := range talents {
+		accumulator.Add(talent)
+	}
+	stats := accumulator.Result()
+
+	if stats.Total != 3 {
+		t.Fatalf("expected 3 talents, got %d", stats.Total)
+	}
+	if len(stats.Captured) != 3 {
+		t.Fatalf("expected 3 weekly buckets, got %d", len(stats.Captured))
+	}
+	if !stats.Captured[0].Start.Equal(query.From) {
+		t.Errorf("expected the first bucket to start at from, got %v", stats.Captured[0].Start)
+	}
+	for i, expected := range []int64{1, 1, 1} {
+		if stats.Captured[i].Count != expected {
+			t.Errorf("bucket %d: expected %d, got %d", i, expected, stats.Captured[i].Count)
+		}
+	}
+	if len(stats.TopTags) != 2 || stats.TopTags[0] != (NamedCount{Name: "golang", Count: 3}) || stats.TopTags[1] != (NamedCount{Name: "aws", Count: 1}) {
+		t.Errorf("unexpected top tags %v", stats.TopTags)
+	}
+	if stats.TopCompanies[0].Count != 2 {
+		t.Errorf("expected companies to be grouped case-insensitively, got %v", stats.TopCompanies)
+	}
+	if stats.TopRoles[0] != (NamedCount{Name: "Backend", Count: 2}) {
+		t.Errorf("unexpected top roles %v", stats.TopRoles)
+	}
+
+	if len(stats.Funnel) != len(Stages) {
+		t.Fatalf("expected one funnel entry per stage, got %d", len(stats.Funnel))
+	}
+	funnel := map[string]int64{}
+	for _, entry := range stats.Funnel {
+		funnel[entry.Name] = entry.Count
+	}
+	if funnel[StageSourced] != 1 || funnel[StageInterviewing] != 1 || funnel[StageHired] != 1 {
+		t.Errorf("unexpected funnel %v", stats.Funnel)
+	}
+}
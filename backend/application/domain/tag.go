@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTagNotFound = errors.New("tag not found")
+	ErrInvalidTag  = errors.New("invalid tag")
+	// ErrTagConflict is returned when a tag name or alias is already used by
+	// another tag.
+	ErrTagConflict = errors.New("tag conflict")
+)
+
+const (
+	TagCategoryLanguage  = "language"
+	TagCategoryFramework = "framework"
+	TagCategoryDomain    = "domain"
+	TagCategorySeniority = "seniority"
+)
+
+var TagCategories = []string{TagCategoryLanguage, TagCategoryFramework, TagCategoryDomain, TagCategorySeniority}
+
+// tagNamespace derives tag ids from their names, so concurrent requests
+// registering the same new tag write the same document.
+var tagNamespace = uuid.MustParse("5b0c3f0e-9f57-4d51-9a8e-3f1a3c2d7b64")
+
+type Tag struct {
+	Id       uuid.UUID `firestore:"-"`
+	Name     string    `firestore:"name"`
+	Aliases  []string  `firestore:"aliases"`
+	Category string    `firestore:"category"`
+	// UsageCount is the number of talents using the tag. Gateways only
+	// change it through AdjustUsage.
+	UsageCount int64 `firestore:"usage_count"`
+	// Keys holds TagKey of the name and of every alias, for lookups.
+	Keys      []string  `firestore:"keys"`
+	CreatedAt time.Time `firestore:"created_at"`
+	UpdatedAt time.Time `firestore:"updated_at"`
+}
+
+// TagKey normalizes a tag for comparison: "Go-Lang", "go lang" and "golang"
+// share the same key.
+func TagKey(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '_', '.', '\t':
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(value)))
+}
+
+func NewTag(name string, category string, aliases []string) (*Tag, error) {
+	now := time.Now().UTC()
+	tag := &Tag{
+		Id:        uuid.NewSHA1(tagNamespace, []byte(TagKey(name))),
+		CreatedAt: now,
+	}
+	err := tag.Update(name, category, aliases)
+	if err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (t *Tag) Update(name string, category string, aliases []string) error {
+	t.Name = strings.TrimSpace(name)
+	t.Category = category
+	t.Aliases = nil
+	for _, alias := range aliases {
+		t.addAlias(alias)
+	}
+	t.UpdatedAt = time.Now().UTC()
+	return t.Validate()
+}
+
+func (t *Tag) Validate() error {
+	if TagKey(t.Name) == "" {
+		return fmt.Errorf("%w: name is null", ErrInvalidTag)
+	}
+	if t.Category != "" && !slices.Contains(TagCategories, t.Category) {
+		return fmt.Errorf("%w: category must be one of %s", ErrInvalidTag, strings.Join(TagCategories, ", "))
+	}
+	t.refreshKeys()
+	return nil
+}
+
+// Matches reports whether value is the tag name or one of its aliases.
+func (t *Tag) Matches(value string) bool {
+	return slices.Contains(t.Keys, TagKey(value))
+}
+
+// Absorb takes the name and aliases of a tag being merged into t.
+func (t *Tag) Absorb(other Tag) {
+	t.addAlias(other.Name)
+	for _, alias := range other.Aliases {
+		t.addAlias(alias)
+	}
+	t.UpdatedAt = time.Now().UTC()
+	t.refreshKeys()
+}
+
+// addAlias ignores empty aliases and the ones matching the name or another
+// alias.
+func (t *Tag) addAlias(alias string) {
+	alias = strings.TrimSpace(alias)
+	key := TagKey(alias)
+	if key == "" || key == TagKey(t.Name) {
+		return
+	}
+	for _, existing := range t.Aliases {
+		if TagKey(existing) == key {
+			return
+		}
+	}
+	t.Aliases = append(t.Aliases, alias)
+}
+
+func (t *Tag) refreshKeys() {
+	t.Keys = []string{TagKey(t.Name)}
+	for _, alias := range t.Aliases {
+		t.Keys = append(t.Keys, TagKey(alias))
+	}
+}
+
+type TagGateway interface {
+	// SaveTag writes every field but UsageCount.
+	SaveTag(ctx context.Context, tag Tag) error
+	GetTagById(ctx context.Context, id string) (*Tag, error)
+	// FindTagsByKeys returns the tags whose name or aliases match one of the
+	// keys.
+	FindTagsByKeys(ctx context.Context, keys []string) ([]Tag, error)
+	// SearchTags returns the most used tags whose name starts with prefix,
+	// compared with TagKey.
+	SearchTags(ctx context.Context, prefix string, category string, limit int) ([]Tag, error)
+	GetAllTags(ctx context.Context) ([]Tag, error)
+	DeleteTag(ctx context.Context, id string) error
+	// AdjustUsage adds each delta to the usage count of the tag with that id.
+	AdjustUsage(ctx context.Context, deltas map[string]int64) error
+}
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxTagWords is the longest tag, in words, the extractor looks for.
+const maxTagWords = 4
+
+// seniorityNumerals are the phrases of seniorityPatterns that only mean a
+// level at the end of a job title, too ambiguous in free text.
+var seniorityNumerals = []string{"ii", "iii", "iv", "v"}
+
+// TagSuggester proposes catalog tags for free text such as a headline.
+type TagSuggester interface {
+	SuggestTags(ctx context.Context, texts ...string) ([]string, error)
+}
+
+type tagEntry struct {
+	name string
+	// spellings must match exactly, set for short keys like "go" or "r"
+	// that are also common words or letters
+	spellings []string
+}
+
+// TagExtractor finds the catalog tags, by name or alias, mentioned in a
+// text, and never suggests tags missing from the catalog. It is
+// deterministic and works offline: accents and case are ignored and the
+// longest match wins, so "Ciência de Dados" is found before "Dados".
+type TagExtractor struct {
+	entries map[string]tagEntry
+}
+
+func NewTagExtractor(tags []Tag) *TagExtractor {
+	e := &TagExtractor{entries: make(map[string]tagEntry)}
+	for _, tag := range tags {
+		for _, spelling := range append([]string{tag.Name}, tag.Aliases...) {
+			e.add(spelling, tag.Name, true)
+		}
+	}
+	// the seniority tags of the catalog are also found by the phrases
+	// NormalizeRole knows for their level
+	levels := make(map[string]string)
+	for _, tag := range tags {
+		if tag.Category != TagCategorySeniority {
+			continue
+		}
+		for _, spelling := range append([]string{tag.Name}, tag.Aliases...) {
+			if level := seniorityOf(spelling); level != "" && levels[level] == "" {
+				levels[level] = tag.Name
+			}
+		}
+	}
+	for _, pattern := range seniorityPatterns {
+		name := levels[pattern.value]
+		if name == "" {
+			continue
+		}
+		for _, phrase := range append([]string{pattern.value}, pattern.phrases...) {
+			if !slices.Contains(seniorityNumerals, phrase) {
+				e.add(phrase, name, false)
+			}
+		}
+	}
+	return e
+}
+
+func (e *TagExtractor) add(spelling string, name string, exact bool) {
+	key := extractorKey(spelling)
+	if key == "" {
+		return
+	}
+	entry, found := e.entries[key]
+	if found && entry.name != name {
+		return
+	}
+	entry.name = name
+	if exact && len(key) <= 2 {
+		entry.spellings = append(entry.spellings, strings.TrimSpace(spelling))
+	}
+	e.entries[key] = entry
+}
+
+// Extract returns the tags found in the texts, in order of appearance and
+// without duplicates.
+func (e *TagExtractor) Extract(texts ...string) []string {
+	var tags []string
+	for _, text := range texts {
+		words := extractorWords(text)
+		for i := 0; i < len(words); {
+			n := e.match(words[i:])
+			if n == 0 {
+				i++
+				continue
+			}
+			name := e.entries[extractorKey(strings.Join(words[i:i+n], " "))].name
+			if !slices.Contains(tags, name) {
+				tags = append(tags, name)
+			}
+			i += n
+		}
+	}
+	return tags
+}
+
+// match returns how many words starting at words[0] form a tag, zero when
+// none does.
+func (e *TagExtractor) match(words []string) int {
+	for n := min(maxTagWords, len(words)); n > 0; n-- {
+		phrase := strings.Join(words[:n], " ")
+		entry, found := e.entries[extractorKey(phrase)]
+		if !found {
+			continue
+		}
+		if len(entry.spellings) > 0 && !slices.Contains(entry.spellings, phrase) {
+			continue
+		}
+		return n
+	}
+	return 0
+}
+
+// extractorWords splits on everything but letters, digits and the symbols
+// used in tag names like C++, C# and Node.js. A trailing dot ends a sentence.
+func extractorWords(text string) []string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("+#.", r)
+	})
+	for i, word := range words {
+		words[i] = strings.TrimRight(word, ".")
+	}
+	return slices.DeleteFunc(words, func(word string) bool { return word == "" })
+}
+
+var removeAccents = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// extractorKey is TagKey without accents, so "Sênior" matches "senior".
+func extractorKey(value string) string {
+	return TagKey(extractorFold(value))
+}
+
+// extractorFold lowercases and removes accents.
+func extractorFold(value string) string {
+	folded, _, err := transform.String(removeAccents, value)
+	if err != nil {
+		folded = value
+	}
+	return strings.ToLower(folded)
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+)
+
+func testExtractor(t *testing.T) *TagExtractor {
+	var tags []Tag
+	for _, spec := range []struct {
+		name     string
+		category string
+		aliases  []string
+	}{
+		{"Go", TagCategoryLanguage, []string{"golang"}},
+		{"Kubernetes", "", []string{"k8s"}},
+		{"AWS", "", []string{"Amazon Web Services"}},
+		{"Node.js", TagCategoryFramework, []string{"node"}},
+		{"Data Science", TagCategoryDomain, []string{"ciência de dados"}},
+		{"Dados", "", nil},
+		{"Sênior", TagCategorySeniority, []string{"senior"}},
+		{"R", TagCategoryLanguage, nil},
+	} {
+		tag, err := NewTag(spec.name, spec.category, spec.aliases)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		tags = append(tags, *tag)
+	}
+	return NewTagExtractor(tags)
+}
+
+func TestTagExtractorEnglish(t *testing.T) {
+	got := testExtractor(t).Extract("Senior Go Engineer | Kubernetes | AWS", "Backend Engineer at Acme")
+	if !slices.Equal(got, []string{"Sênior", "Go", "Kubernetes", "AWS"}) {
+		t.Errorf("unexpected tags %v", got)
+	}
+}
+
+func TestTagExtractorPortuguese(t *testing.T) {
+	got := testExtractor(t).Extract("Engenheira de Software Sr. | Ciência de Dados, Node.js e Amazon Web Services")
+	if !slices.Equal(got, []string{"Sênior", "Data Science", "Node.js", "AWS"}) {
+		t.Errorf("unexpected tags %v", got)
+	}
+}
+
+func TestTagExtractorShortTagsNeedExactSpelling(t *testing.T) {
+	extractor := testExtractor(t)
+	if got := extractor.Extract("Ready to go, r&d lover"); len(got) != 0 {
+		t.Errorf("expected no tags, got %v", got)
+	}
+	if got := extractor.Extract("Statistics with R and Go"); !slices.Equal(got, []string{"R", "Go"}) {
+		t.Errorf("expected [R Go], got %v", got)
+	}
+}
+
+func TestTagExtractorSeniorityPhrases(t *testing.T) {
+	var tags []Tag
+	for _, name := range []string{"Júnior", "Pleno", "Staff", "Tech Lead"} {
+		tag, err := NewTag(name, TagCategorySeniority, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		tags = append(tags, *tag)
+	}
+	extractor := NewTagExtractor(tags)
+	tests := map[string][]string{
+		"Desenvolvedora Pleno":    {"Pleno"},
+		"Mid-level Data Engineer": {"Pleno"},
+		"Estagiário de TI":        {"Júnior"},
+		"Jr Frontend Developer":   {"Júnior"},
+		"Tech Lead | Payments":    {"Tech Lead"},
+		"Líder Técnica":           {"Tech Lead"},
+		"Staff Engineer":          {"Staff"},
+		"Principal Engineer":      {"Staff"},
+		"Senior Engineer":         nil,
+		"Engineer III":            nil,
+	}
+	for text, expected := range tests {
+		if got := extractor.Extract(text); !slices.Equal(got, expected) {
+			t.Errorf("%q: expected %v, got %v", text, expected, got)
+		}
+	}
+}
+
+func TestTagExtractorSuggestsOnlyCatalogTags(t *testing.T) {
+	if got := NewTagExtractor(nil).Extract("Senior Staff Engineer, Tech Lead"); len(got) != 0 {
+		t.Errorf("expected no tags without a catalog, got %v", got)
+	}
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestTagKey(t *testing.T) {
+	for _, value := range []string{"golang", "GoLang", "go-lang", " go lang ", "go_lang", "go.lang"} {
+		if key := TagKey(value); key != "golang" {
+			t.Errorf("expected %q to have key golang, got %q", value, key)
+		}
+	}
+	if TagKey("C++") != "c++" || TagKey("C#") != "c#" {
+		t.Error("expected symbols other than separators to be kept")
+	}
+}
+
+func TestNewTag(t *testing.T) {
+	tag, err := NewTag(" Go ", TagCategoryLanguage, []string{"golang", "go-lang", "GO", ""})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tag.Name != "Go" || !slices.Equal(tag.Aliases, []string{"golang"}) {
+		t.Errorf("expected Go with alias golang, got %s %v", tag.Name, tag.Aliases)
+	}
+	if !tag.Matches("Go-Lang") || tag.Matches("rust") {
+		t.Error("unexpected Matches result")
+	}
+
+	other, _ := NewTag("go", "", nil)
+	if other.Id != tag.Id {
+		t.Error("expected tags with the same key to share the id")
+	}
+
+	_, err = NewTag(" - ", "", nil)
+	if !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("expected ErrInvalidTag for an empty name, got %v", err)
+	}
+	_, err = NewTag("Go", "hobby", nil)
+	if !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("expected ErrInvalidTag for an unknown category, got %v", err)
+	}
+}
+
+func TestTagAbsorb(t *testing.T) {
+	tag, _ := NewTag("Go", TagCategoryLanguage, []string{"golang"})
+	other, _ := NewTag("go language", "", []string{"Golang", "gopher"})
+
+	tag.Absorb(*other)
+	if !slices.Equal(tag.Aliases, []string{"golang", "go language", "gopher"}) {
+		t.Errorf("unexpected aliases %v", tag.Aliases)
+	}
+	if !tag.Matches("gopher") {
+		t.Error("expected the keys to include the absorbed aliases")
+	}
+}
+
+func TestReplaceTag(t *testing.T) {
+	talent, _ := Create("https://linkedin.com/in/test", "Backend", "John Doe", "Developer", "", "", []string{"golang", "Go", "docker"}, "")
+	talent.PullEvents()
+
+	if talent.ReplaceTag([]string{"rust"}, "Rust") {
+		t.Error("expected no change without matching tags")
+	}
+	if !talent.ReplaceTag([]string{"go-lang"}, "Go") {
+		t.Fatal("expected the tags to change")
+	}
+	if !slices.Equal(talent.Tags, []string{"Go", "docker"}) {
+		t.Errorf("expected [Go docker], got %v", talent.Tags)
+	}
+	if events := talent.PullEvents(); len(events) != 1 || events[0].Type != EventTalentUpdated {
+		t.Errorf("expected a talent.updated event, got %v", events)
+	}
+}
@@ -2,11 +2,42 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+var (
+	ErrTalentNotFound  = errors.New("talent not found")
+	ErrVersionConflict = errors.New("talent version conflict")
+	ErrTalentArchived  = errors.New("talent is archived")
+	ErrInvalidStage    = errors.New("invalid pipeline stage")
+	ErrInvalidTalent   = errors.New("invalid talent")
+)
+
+const (
+	StageSourced      = "sourced"
+	StageContacted    = "contacted"
+	StageScreening    = "screening"
+	StageInterviewing = "interviewing"
+	StageOffer        = "offer"
+	StageHired        = "hired"
+	StageRejected     = "rejected"
+)
+
+// Stages lists the pipeline stages in funnel order.
+var Stages = []string{
+	StageSourced,
+	StageContacted,
+	StageScreening,
+	StageInterviewing,
+	StageOffer,
+	StageHired,
+	StageRejected,
+}
+
 type Talent struct {
 	Id             uuid.UUID `firestore:"-"`
 	ProfileURL     string    `firestore:"profile_url"`
@@ -18,6 +49,33 @@ type Talent struct {
 	Tags           []string  `firestore:"tags"`
 	Notes          string    `firestore:"notes"`
 	CapturedAt     time.Time `firestore:"captured_at"`
+	UpdatedAt      time.Time `firestore:"updated_at"`
+	Stage          string    `firestore:"stage"`
+	ArchivedAt     time.Time `firestore:"archived_at"`
+	Version        int64     `firestore:"version"`
+
+	// Role is normalized from PossibleRole and CurrentRole on every change.
+	Role RoleProfile `firestore:"role"`
+
+	Contacts Contacts `firestore:"contacts"`
+	// ContactKeys holds one normalized key per contact point, ProfileURL
+	// included, so a talent can be found by any of them.
+	ContactKeys []string `firestore:"contact_keys"`
+
+	Location Location `firestore:"location"`
+	// Compensation is only shown to recruiters and admins.
+	Compensation Compensation `firestore:"compensation"`
+
+	// CVKeywords are the words found in the talent's attachments, for search.
+	CVKeywords []string `firestore:"cv_keywords"`
+
+	// Scorecards aggregates the scorecards of the talent, kept here to sort
+	// the list by rating.
+	Scorecards ScorecardSummary `firestore:"scorecards"`
+
+	// events raised since the talent was loaded, stored by the gateway in
+	// the same transaction as the talent itself.
+	events []TalentEvent
 }
 
 func Create(profileUrl string, possibleRole string, fullName string, headline string, currentCompany string,
@@ -33,28 +91,185 @@ func Create(profileUrl string, possibleRole string, fullName string, headline st
 		Tags:           tags,
 		Notes:          notes,
 		CapturedAt:     time.Now().UTC(),
+		Stage:          StageSourced,
+		Role:           NormalizeRoles(possibleRole, currentRole),
+		ContactKeys:    Contacts{}.Keys(profileUrl),
 	}
 
 	err := talent.Validate()
 	if err != nil {
 		return nil, err
 	}
+	talent.record(NewTalentEvent(EventTalentCreated, talent.Id.String(), talent))
 	return talent, nil
 
 }
 
+func (t *Talent) Update(profileUrl string, possibleRole string, fullName string, headline string, currentCompany string,
+	currentRole string, tags []string, notes string) error {
+	t.ProfileURL = profileUrl
+	t.PossibleRole = possibleRole
+	t.FullName = fullName
+	t.Headline = headline
+	t.CurrentCompany = currentCompany
+	t.CurrentRole = currentRole
+	t.Role = NormalizeRoles(possibleRole, currentRole)
+	t.ContactKeys = t.Contacts.Keys(profileUrl)
+	t.Tags = tags
+	t.Notes = notes
+	t.UpdatedAt = time.Now().UTC()
+
+	err := t.Validate()
+	if err != nil {
+		return err
+	}
+	t.record(NewTalentEvent(EventTalentUpdated, t.Id.String(), t))
+	return nil
+}
+
+// SetContacts replaces the contact points with their normalized form. It is
+// meant to be called along Create or Update, which record the event.
+func (t *Talent) SetContacts(contacts Contacts) error {
+	normalized, err := contacts.Normalize()
+	if err != nil {
+		return err
+	}
+	t.Contacts = normalized
+	t.ContactKeys = normalized.Keys(t.ProfileURL)
+	return nil
+}
+
+// SetLocation replaces the location with its normalized form. Like
+// SetContacts, it is meant to be called along Create or Update.
+func (t *Talent) SetLocation(location Location) error {
+	location = location.Normalize()
+	err := location.Validate()
+	if err != nil {
+		return err
+	}
+	t.Location = location
+	return nil
+}
+
+// SetCompensation replaces the expectation, or clears it when c is zero.
+func (t *Talent) SetCompensation(c Compensation) error {
+	c = c.Normalize()
+	err := c.Validate()
+	if err != nil {
+		return err
+	}
+	t.Compensation = c
+	return nil
+}
+
+// ReplaceTag swaps every tag matching one of values, compared with TagKey,
+// for canonical. It reports whether the tags changed.
+func (t *Talent) ReplaceTag(values []string, canonical string) bool {
+	keys := make([]string, 0, len(values))
+	for _, value := range values {
+		keys = append(keys, TagKey(value))
+	}
+
+	var tags []string
+	changed := false
+	for _, tag := range t.Tags {
+		if slices.Contains(keys, TagKey(tag)) {
+			changed = true
+			tag = canonical
+		}
+		if !slices.ContainsFunc(tags, func(existing string) bool { return TagKey(existing) == TagKey(tag) }) {
+			tags = append(tags, tag)
+		}
+	}
+	if !changed {
+		return false
+	}
+
+	t.Tags = tags
+	t.UpdatedAt = time.Now().UTC()
+	t.record(NewTalentEvent(EventTalentUpdated, t.Id.String(), t))
+	return true
+}
+
+// NormalizedRole also covers talents saved before roles were normalized.
+func (t *Talent) NormalizedRole() RoleProfile {
+	if t.Role == (RoleProfile{}) {
+		return NormalizeRoles(t.PossibleRole, t.CurrentRole)
+	}
+	return t.Role
+}
+
+// CurrentStage treats talents captured before the pipeline existed as sourced.
+func (t *Talent) CurrentStage() string {
+	if t.Stage == "" {
+		return StageSourced
+	}
+	return t.Stage
+}
+
+func (t *Talent) ChangeStage(stage string) error {
+	if !slices.Contains(Stages, stage) {
+		return ErrInvalidStage
+	}
+	if t.IsArchived() {
+		return ErrTalentArchived
+	}
+	previous := t.CurrentStage()
+	if previous == stage {
+		return nil
+	}
+
+	t.Stage = stage
+	t.UpdatedAt = time.Now().UTC()
+	event := NewTalentEvent(EventTalentStageChanged, t.Id.String(), t)
+	event.FromStage = previous
+	event.ToStage = stage
+	t.record(event)
+	return nil
+}
+
+// Archive hides the talent from the default listings without deleting it.
+func (t *Talent) Archive() error {
+	if t.IsArchived() {
+		return ErrTalentArchived
+	}
+	t.ArchivedAt = time.Now().UTC()
+	t.UpdatedAt = t.ArchivedAt
+	t.record(NewTalentEvent(EventTalentArchived, t.Id.String(), t))
+	return nil
+}
+
+func (t *Talent) IsArchived() bool {
+	return !t.ArchivedAt.IsZero()
+}
+
+func (t *Talent) record(event TalentEvent) {
+	t.events = append(t.events, event)
+}
+
+// PullEvents returns the events raised so far and forgets them.
+func (t *Talent) PullEvents() []TalentEvent {
+	events := t.events
+	t.events = nil
+	return events
+}
+
 func (t *Talent) Validate() error {
 	if t.ProfileURL == "" {
-		return errors.New("url is null")
+		return fmt.Errorf("%w: url is null", ErrInvalidTalent)
 	}
 	if t.PossibleRole == "" {
-		return errors.New("role is null")
+		return fmt.Errorf("%w: role is null", ErrInvalidTalent)
 	}
 	if t.FullName == "" {
-		return errors.New("name is null")
+		return fmt.Errorf("%w: name is null", ErrInvalidTalent)
 	}
 	if t.Headline == "" {
-		return errors.New("headline is null")
+		return fmt.Errorf("%w: headline is null", ErrInvalidTalent)
 	}
-	return nil
+	err := t.Location.Validate()
+	if err != nil {
+		return err
+	}
+	return t.Compensation.Validate()
 }
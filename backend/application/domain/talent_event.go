@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventTalentCreated      = "talent.created"
+	EventTalentUpdated      = "talent.updated"
+	EventTalentStageChanged = "talent.stage_changed"
+	EventTalentArchived     = "talent.archived"
+	EventTalentDeleted      = "talent.deleted"
+	EventTaskOverdue        = "task.overdue"
+	EventTaskDigest         = "task.digest"
+)
+
+var EventTypes = []string{
+	EventTalentCreated,
+	EventTalentUpdated,
+	EventTalentStageChanged,
+	EventTalentArchived,
+	EventTalentDeleted,
+	EventTaskOverdue,
+	EventTaskDigest,
+}
+
+type TalentEvent struct {
+	Id         string    `firestore:"-"`
+	Type       string    `firestore:"type"`
+	OccurredAt time.Time `firestore:"occurred_at"`
+	TalentId   string    `firestore:"talent_id"`
+	// Talent is the state after the change, nil for deletions.
+	Talent *Talent `firestore:"talent"`
+	// FromStage and ToStage are only set on talent.stage_changed.
+	FromStage string `firestore:"from_stage"`
+	ToStage   string `firestore:"to_stage"`
+	// Task is set on task.overdue and Digest on task.digest, which has no
+	// talent.
+	Task   *FollowUpTask `firestore:"task"`
+	Digest *TaskDigest   `firestore:"digest"`
+}
+
+func NewTalentEvent(eventType string, talentId string, talent *Talent) TalentEvent {
+	return TalentEvent{
+		Id:         uuid.NewString(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		TalentId:   talentId,
+		Talent:     talent,
+	}
+}
+
+func NewTaskOverdueEvent(task FollowUpTask) TalentEvent {
+	event := NewTalentEvent(EventTaskOverdue, task.TalentId, nil)
+	event.Task = &task
+	return event
+}
+
+func NewTaskDigestEvent(digest TaskDigest) TalentEvent {
+	event := NewTalentEvent(EventTaskDigest, "", nil)
+	event.Digest = &digest
+	return event
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TalentFilter is the query model of the talent list, also kept by saved
+// searches. Empty fields match anything.
+type TalentFilter struct {
+	// Query matches words in the talent fields and in the text of their
+	// attachments.
+	Query        string `firestore:"query"`
+	Name         string `firestore:"name"`
+	PossibleRole string `firestore:"possible_role"`
+	// Tags must all be present, compared with TagKey.
+	Tags  []string `firestore:"tags"`
+	Stage string   `firestore:"stage"`
+	// Function and Seniority filter on the normalized role.
+	Function     string             `firestore:"function"`
+	Seniority    string             `firestore:"seniority"`
+	Location     LocationFilter     `firestore:"location"`
+	Compensation CompensationFilter `firestore:"compensation"`
+	// IncludeArchived also returns archived talents, which are hidden by
+	// default.
+	IncludeArchived bool `firestore:"include_archived"`
+}
+
+// UsesCompensation reports whether the filter needs access to compensation.
+func (f TalentFilter) UsesCompensation() bool {
+	return !f.Compensation.IsZero()
+}
+
+func (f TalentFilter) Normalize() TalentFilter {
+	f.Query = strings.TrimSpace(f.Query)
+	f.Name = strings.TrimSpace(f.Name)
+	f.PossibleRole = strings.TrimSpace(f.PossibleRole)
+	var tags []string
+	for _, tag := range f.Tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	f.Tags = tags
+	f.Stage = strings.ToLower(strings.TrimSpace(f.Stage))
+	f.Function = strings.ToLower(strings.TrimSpace(f.Function))
+	f.Seniority = strings.ToLower(strings.TrimSpace(f.Seniority))
+	f.Compensation = f.Compensation.Normalize()
+	return f
+}
+
+// Validate expects a normalized filter.
+func (f TalentFilter) Validate() error {
+	if f.Stage != "" && !slices.Contains(Stages, f.Stage) {
+		return fmt.Errorf("%w: stage must be one of %s", ErrInvalidStage, strings.Join(Stages, ", "))
+	}
+	if f.Function != "" && !slices.Contains(Functions, f.Function) {
+		return fmt.Errorf("%w: function must be one of %s", ErrInvalidRoleProfile, strings.Join(Functions, ", "))
+	}
+	if f.Seniority != "" && !slices.Contains(Seniorities, f.Seniority) {
+		return fmt.Errorf("%w: seniority must be one of %s", ErrInvalidRoleProfile, strings.Join(Seniorities, ", "))
+	}
+	err := f.Location.Validate()
+	if err != nil {
+		return err
+	}
+	return f.Compensation.Validate()
+}
+
+// Matches expects a normalized filter.
+func (f TalentFilter) Matches(t Talent) bool {
+	if f.Name != "" && !strings.Contains(strings.ToLower(t.FullName), strings.ToLower(f.Name)) {
+		return false
+	}
+	if f.PossibleRole != "" && !strings.Contains(strings.ToLower(t.PossibleRole), strings.ToLower(f.PossibleRole)) {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !slices.ContainsFunc(t.Tags, func(existing string) bool { return TagKey(existing) == TagKey(tag) }) {
+			return false
+		}
+	}
+	if !t.MatchesSearch(SearchKeywords(f.Query, 0)) {
+		return false
+	}
+	if f.Stage != "" && t.CurrentStage() != f.Stage {
+		return false
+	}
+	role := t.NormalizedRole()
+	if f.Function != "" && role.Function != f.Function {
+		return false
+	}
+	if f.Seniority != "" && role.Seniority != f.Seniority {
+		return false
+	}
+	if !f.Location.Matches(t.Location) {
+		return false
+	}
+	if !f.Compensation.Matches(t.Compensation) {
+		return false
+	}
+	return f.IncludeArchived || !t.IsArchived()
+}
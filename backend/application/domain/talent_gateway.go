@@ -3,7 +3,28 @@ package domain
 import "context"
 
 type TalentGateway interface {
-	Save(ctx context.Context, talent Talent) error
-	GetTalents(ctx context.Context, limit int, cursor string) ([]Talent, string, error)
+	// Save persists the talent only if talent.Version matches the stored
+	// version (zero for new talents) and increments it, returning
+	// ErrVersionConflict otherwise. Delete follows the same rule.
+	//
+	// Both write their events to the outbox in the same transaction: Save
+	// the ones pulled from the talent and Delete a talent.deleted event.
+	//
+	// Save returns ErrContactConflict when another talent has one of the
+	// talent's emails, checked atomically with the write.
+	Save(ctx context.Context, talent *Talent) error
+	Delete(ctx context.Context, id string, version int64) error
+	// GetTalents returns a page of talents in the sort order and the cursor
+	// of the next page, empty on the last one. It returns ErrInvalidCursor
+	// for cursors that were not returned for the same sort.
+	GetTalents(ctx context.Context, limit int, cursor string, sort TalentSort) ([]Talent, string, error)
 	GetTalentById(ctx context.Context, id string) (*Talent, error)
+	// GetTalentsByTags returns up to limit talents having at least one of the
+	// tags, compared exactly.
+	GetTalentsByTags(ctx context.Context, tags []string, limit int) ([]Talent, error)
+	// FindTalentsByContact returns up to limit talents having at least one of
+	// the contact keys.
+	FindTalentsByContact(ctx context.Context, keys []string, limit int) ([]Talent, error)
+	// Ping reports whether the underlying storage is reachable.
+	Ping(ctx context.Context) error
 }
@@ -0,0 +1,219 @@
+package domain
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidSort   = errors.New("invalid sort")
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+const (
+	SortCapturedAt     = "captured_at"
+	SortUpdatedAt      = "updated_at"
+	SortFullName       = "full_name"
+	SortCurrentCompany = "current_company"
+	SortRating         = "rating"
+	// MaxSortKeys bounds the composite indexes needed by the gateways.
+	MaxSortKeys = 2
+)
+
+type sortField struct {
+	value  func(t *Talent) any
+	decode func(raw json.RawMessage) (any, error)
+}
+
+func decodeSortValue[T any](raw json.RawMessage) (any, error) {
+	var value T
+	err := json.Unmarshal(raw, &value)
+	return value, err
+}
+
+// sortFields is the allowlist of the talent list sort keys.
+var sortFields = map[string]sortField{
+	SortCapturedAt: {
+		value:  func(t *Talent) any { return t.CapturedAt },
+		decode: decodeSortValue[time.Time],
+	},
+	SortUpdatedAt: {
+		value:  func(t *Talent) any { return t.UpdatedAt },
+		decode: decodeSortValue[time.Time],
+	},
+	SortFullName: {
+		value:  func(t *Talent) any { return t.FullName },
+		decode: decodeSortValue[string],
+	},
+	SortCurrentCompany: {
+		value:  func(t *Talent) any { return t.CurrentCompany },
+		decode: decodeSortValue[string],
+	},
+	SortRating: {
+		value:  func(t *Talent) any { return t.Scorecards.Average },
+		decode: decodeSortValue[float64],
+	},
+}
+
+// SortKey orders by Field, descending when Desc is set.
+type SortKey struct {
+	Field string
+	Desc  bool
+}
+
+// TalentSort orders the talent list. Ties are broken by talent id, in the
+// direction of the last key.
+type TalentSort []SortKey
+
+var DefaultTalentSort = TalentSort{{Field: SortCapturedAt, Desc: true}}
+
+// ParseTalentSort reads comma separated keys like full_name,-captured_at,
+// where the minus sign means descending. Text is compared byte by byte, so
+// upper case comes first. An empty value is the default sort.
+func ParseTalentSort(value string) (TalentSort, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return DefaultTalentSort, nil
+	}
+
+	var sort TalentSort
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		key := SortKey{Field: field}
+		if strings.HasPrefix(field, "-") {
+			key = SortKey{Field: field[1:], Desc: true}
+		}
+		if _, ok := sortFields[key.Field]; !ok {
+			return nil, fmt.Errorf("%w: sort keys must be one of %s, prefixed with - for descending", ErrInvalidSort, strings.Join(TalentSortFields(), ", "))
+		}
+		if slices.ContainsFunc(sort, func(existing SortKey) bool { return existing.Field == key.Field }) {
+			return nil, fmt.Errorf("%w: %s is repeated", ErrInvalidSort, key.Field)
+		}
+		sort = append(sort, key)
+	}
+	if len(sort) > MaxSortKeys {
+		return nil, fmt.Errorf("%w: at most %d sort keys", ErrInvalidSort, MaxSortKeys)
+	}
+	return sort, nil
+}
+
+func TalentSortFields() []string {
+	return []string{SortCapturedAt, SortUpdatedAt, SortFullName, SortCurrentCompany, SortRating}
+}
+
+func (s TalentSort) String() string {
+	keys := make([]string, 0, len(s))
+	for _, key := range s {
+		if key.Desc {
+			keys = append(keys, "-"+key.Field)
+		} else {
+			keys = append(keys, key.Field)
+		}
+	}
+	return strings.Join(keys, ",")
+}
+
+// Values returns the values of the sort keys of the talent, in order.
+func (s TalentSort) Values(t *Talent) []any {
+	values := make([]any, 0, len(s))
+	for _, key := range s {
+		values = append(values, sortFields[key.Field].value(t))
+	}
+	return values
+}
+
+// Compare orders two talents like the gateways do.
+func (s TalentSort) Compare(a *Talent, b *Talent) int {
+	return s.compare(a, s.Values(b), b.Id.String())
+}
+
+// After reports whether the talent comes after the position decoded from a
+// cursor.
+func (s TalentSort) After(t *Talent, values []any, id string) bool {
+	return s.compare(t, values, id) > 0
+}
+
+func (s TalentSort) compare(t *Talent, values []any, id string) int {
+	for i, key := range s {
+		c := compareSortValues(sortFields[key.Field].value(t), values[i])
+		if key.Desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	c := strings.Compare(t.Id.String(), id)
+	if len(s) > 0 && s[len(s)-1].Desc {
+		c = -c
+	}
+	return c
+}
+
+func compareSortValues(a any, b any) int {
+	switch a := a.(type) {
+	case time.Time:
+		return a.Compare(b.(time.Time))
+	case float64:
+		return cmp.Compare(a, b.(float64))
+	case string:
+		return strings.Compare(a, b.(string))
+	}
+	return 0
+}
+
+type talentCursor struct {
+	Sort   string            `json:"s"`
+	Values []json.RawMessage `json:"v"`
+	Id     string            `json:"id"`
+}
+
+// EncodeCursor returns the cursor of the page starting after the talent.
+func (s TalentSort) EncodeCursor(t *Talent) (string, error) {
+	cursor := talentCursor{Sort: s.String(), Id: t.Id.String()}
+	for _, value := range s.Values(t) {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		cursor.Values = append(cursor.Values, raw)
+	}
+	encoded, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+// DecodeCursor returns the sort values and the id of the last talent of the
+// previous page. Cursors of another sort are rejected.
+func (s TalentSort) DecodeCursor(value string) ([]any, string, error) {
+	encoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+	var cursor talentCursor
+	err = json.Unmarshal(encoded, &cursor)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+	if cursor.Sort != s.String() || len(cursor.Values) != len(s) || cursor.Id == "" {
+		return nil, "", fmt.Errorf("%w: the cursor belongs to another sort", ErrInvalidCursor)
+	}
+
+	values := make([]any, 0, len(s))
+	for i, key := range s {
+		v, err := sortFields[key.Field].decode(cursor.Values[i])
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+		}
+		values = append(values, v)
+	}
+	return values, cursor.Id, nil
+}
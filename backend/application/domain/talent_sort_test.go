@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTalentSort(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+		err   error
+	}{
+		{"", "-captured_at", nil},
+		{"rating", "rating", nil},
+		{" -rating ", "-rating", nil},
+		{"salary", "", ErrInvalidSort},
+		{"-", "", ErrInvalidSort},
+		{"full_name, -captured_at", "full_name,-captured_at", nil},
+		{"-updated_at,current_company", "-updated_at,current_company", nil},
+		{"rating,-rating", "", ErrInvalidSort},
+		{"rating,full_name,captured_at", "", ErrInvalidSort},
+	}
+	for _, tt := range tests {
+		sort, err := ParseTalentSort(tt.value)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%q: expected %v, got %v", tt.value, tt.err, err)
+			continue
+		}
+		if err == nil && sort.String() != tt.want {
+			t.Errorf("%q: expected %s, got %s", tt.value, tt.want, sort)
+		}
+	}
+}
+
+func TestTalentSortCursor(t *testing.T) {
+	talent, _ := Create("https://linkedin.com/in/ana", "Data Engineer", "Ana Souza", "Data", "Acme", "Data Engineer", nil, "")
+	talent.CapturedAt = time.Date(2026, 3, 10, 12, 0, 0, 123456000, time.UTC)
+	talent.Scorecards.Average = 4.25
+	sort, _ := ParseTalentSort("-rating")
+
+	cursor, err := sort.EncodeCursor(talent)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	values, id, err := sort.DecodeCursor(cursor)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if values[0] != 4.25 || id != talent.Id.String() {
+		t.Errorf("expected the rating and id back, got %v %s", values, id)
+	}
+
+	lower := *talent
+	lower.Scorecards.Average = 3
+	if !sort.After(&lower, values, id) || sort.After(talent, values, id) {
+		t.Error("expected only the lower rated talent to come after the cursor")
+	}
+
+	_, _, err = DefaultTalentSort.DecodeCursor(cursor)
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("expected ErrInvalidCursor for another sort, got %v", err)
+	}
+	_, _, err = sort.DecodeCursor("not a cursor")
+	if !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("expected ErrInvalidCursor, got %v", err)
+	}
+}
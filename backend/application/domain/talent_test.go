@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -55,8 +56,8 @@ func TestCreateMissingProfileURL(t *testing.T) {
 		t.Fatal("expected talent to be nil")
 	}
 
-	if err.Error() != "url is null" {
-		t.Errorf("expected error message 'url is null', got %s", err.Error())
+	if !errors.Is(err, ErrInvalidTalent) || err.Error() != "invalid talent: url is null" {
+		t.Errorf("expected error message 'invalid talent: url is null', got %s", err.Error())
 	}
 }
 
@@ -71,8 +72,8 @@ func TestCreateMissingRole(t *testing.T) {
 		t.Fatal("expected talent to be nil")
 	}
 
-	if err.Error() != "role is null" {
-		t.Errorf("expected error message 'role is null', got %s", err.Error())
+	if !errors.Is(err, ErrInvalidTalent) || err.Error() != "invalid talent: role is null" {
+		t.Errorf("expected error message 'invalid talent: role is null', got %s", err.Error())
 	}
 }
 
@@ -87,8 +88,8 @@ func TestCreateMissingFullName(t *testing.T) {
 		t.Fatal("expected talent to be nil")
 	}
 
-	if err.Error() != "name is null" {
-		t.Errorf("expected error message 'name is null', got %s", err.Error())
+	if !errors.Is(err, ErrInvalidTalent) || err.Error() != "invalid talent: name is null" {
+		t.Errorf("expected error message 'invalid talent: name is null', got %s", err.Error())
 	}
 }
 
@@ -103,8 +104,8 @@ func TestCreateMissingHeadline(t *testing.T) {
 		t.Fatal("expected talent to be nil")
 	}
 
-	if err.Error() != "headline is null" {
-		t.Errorf("expected error message 'headline is null', got %s", err.Error())
+	if !errors.Is(err, ErrInvalidTalent) || err.Error() != "invalid talent: headline is null" {
+		t.Errorf("expected error message 'invalid talent: headline is null', got %s", err.Error())
 	}
 }
 
@@ -143,3 +144,86 @@ func TestCreateWithEmptyTags(t *testing.T) {
 		t.Errorf("expected empty tags, got %v", talent.Tags)
 	}
 }
+
+func TestUpdateSetsUpdatedAt(t *testing.T) {
+	talent, _ := Create("https://test.com", "Dev", "Name", "Headline", "Company", "Role", []string{}, "Notes")
+
+	err := talent.Update("https://test.com", "Staff", "Name", "Headline", "Company", "Role", []string{"go"}, "Notes")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if talent.PossibleRole != "Staff" {
+		t.Errorf("expected PossibleRole to be 'Staff', got %s", talent.PossibleRole)
+	}
+
+	if talent.UpdatedAt.IsZero() {
+		t.Error("expected UpdatedAt to be set")
+	}
+}
+
+func TestUpdateValidates(t *testing.T) {
+	talent, _ := Create("https://test.com", "Dev", "Name", "Headline", "Company", "Role", []string{}, "Notes")
+
+	err := talent.Update("https://test.com", "Dev", "", "Headline", "Company", "Role", []string{}, "Notes")
+	if !errors.Is(err, ErrInvalidTalent) || err.Error() != "invalid talent: name is null" {
+		t.Errorf("expected error message 'invalid talent: name is null', got %v", err)
+	}
+}
+
+func TestCreateRaisesEvent(t *testing.T) {
+	talent, _ := Create("https://linkedin.com/in/john", "Developer", "John Doe", "Headline", "", "", nil, "")
+
+	events := talent.PullEvents()
+	if len(events) != 1 || events[0].Type != EventTalentCreated || events[0].TalentId != talent.Id.String() {
+		t.Fatalf("expected a talent.created event, got %+v", events)
+	}
+	if len(talent.PullEvents()) != 0 {
+		t.Error("expected PullEvents to forget returned events")
+	}
+	if talent.Stage != StageSourced {
+		t.Errorf("expected new talents to be sourced, got %s", talent.Stage)
+	}
+}
+
+func TestChangeStage(t *testing.T) {
+	talent := &Talent{Id: uuid.New()}
+
+	if err := talent.ChangeStage("hiring"); err != ErrInvalidStage {
+		t.Errorf("expected ErrInvalidStage, got %v", err)
+	}
+	if err := talent.ChangeStage(StageSourced); err != nil || len(talent.PullEvents()) != 0 {
+		t.Errorf("expected no event when the stage does not change, got %v", err)
+	}
+
+	err := talent.ChangeStage(StageOffer)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	events := talent.PullEvents()
+	if len(events) != 1 || events[0].FromStage != StageSourced || events[0].ToStage != StageOffer {
+		t.Errorf("expected a sourced -> offer event, got %+v", events)
+	}
+}
+
+func TestArchive(t *testing.T) {
+	talent := &Talent{Id: uuid.New()}
+
+	err := talent.Archive()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !talent.IsArchived() {
+		t.Error("expected talent to be archived")
+	}
+	if events := talent.PullEvents(); len(events) != 1 || events[0].Type != EventTalentArchived {
+		t.Errorf("expected a talent.archived event, got %+v", events)
+	}
+
+	if err := talent.Archive(); err != ErrTalentArchived {
+		t.Errorf("expected ErrTalentArchived, got %v", err)
+	}
+	if err := talent.ChangeStage(StageHired); err != ErrTalentArchived {
+		t.Errorf("expected ErrTalentArchived when changing the stage, got %v", err)
+	}
+}
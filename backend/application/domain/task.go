@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTaskNotFound = errors.New("task not found")
+	ErrInvalidTask  = errors.New("invalid task")
+)
+
+// FollowUpTask reminds a recruiter to get back to a talent.
+type FollowUpTask struct {
+	Id          uuid.UUID `firestore:"-"`
+	TalentId    string    `firestore:"talent_id"`
+	Assignee    string    `firestore:"assignee"`
+	Note        string    `firestore:"note"`
+	DueAt       time.Time `firestore:"due_at"`
+	Done        bool      `firestore:"done"`
+	Overdue     bool      `firestore:"overdue"`
+	CreatedAt   time.Time `firestore:"created_at"`
+	CompletedAt time.Time `firestore:"completed_at"`
+}
+
+func NewFollowUpTask(talentId string, assignee string, note string, dueAt time.Time) (*FollowUpTask, error) {
+	task := &FollowUpTask{
+		Id:        uuid.New(),
+		TalentId:  talentId,
+		Assignee:  strings.TrimSpace(assignee),
+		Note:      note,
+		DueAt:     dueAt.UTC(),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err := task.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func (t *FollowUpTask) Validate() error {
+	if t.TalentId == "" {
+		return fmt.Errorf("%w: talent is null", ErrInvalidTask)
+	}
+	if t.Assignee == "" {
+		return fmt.Errorf("%w: assignee is null", ErrInvalidTask)
+	}
+	if t.DueAt.IsZero() {
+		return fmt.Errorf("%w: due date is null", ErrInvalidTask)
+	}
+	return nil
+}
+
+// Reschedule changes the due date and clears the overdue flag when the new
+// date is still ahead.
+func (t *FollowUpTask) Reschedule(dueAt time.Time, now time.Time) {
+	t.DueAt = dueAt.UTC()
+	if t.DueAt.After(now) {
+		t.Overdue = false
+	}
+}
+
+func (t *FollowUpTask) Complete(now time.Time) {
+	t.Done = true
+	t.Overdue = false
+	t.CompletedAt = now.UTC()
+}
+
+func (t *FollowUpTask) Reopen() {
+	t.Done = false
+	t.CompletedAt = time.Time{}
+}
+
+// MarkOverdue flags open tasks past their due date. It reports whether the
+// flag changed, so the overdue event is raised only once.
+func (t *FollowUpTask) MarkOverdue(now time.Time) bool {
+	if t.Done || t.Overdue || !t.DueAt.Before(now) {
+		return false
+	}
+	t.Overdue = true
+	return true
+}
+
+// TaskDigest is the daily summary of open tasks sent to one assignee.
+type TaskDigest struct {
+	Assignee string         `firestore:"assignee"`
+	Day      string         `firestore:"day"`
+	Overdue  []FollowUpTask `firestore:"overdue"`
+	DueToday []FollowUpTask `firestore:"due_today"`
+}
+
+type TaskFilter struct {
+	TalentId string
+	Assignee string
+	// DueBefore keeps tasks due strictly before it, ignored when zero.
+	DueBefore time.Time
+	Done      *bool
+	Limit     int
+}
+
+type TaskGateway interface {
+	SaveTask(ctx context.Context, task FollowUpTask) error
+	// MarkTaskOverdue flags the stored task as overdue without touching its
+	// other fields, and writes its task.overdue event to the outbox in the
+	// same transaction. It reports false when the task is gone or
+	// MarkOverdue does not apply to it anymore, e.g. it was completed
+	// meanwhile.
+	MarkTaskOverdue(ctx context.Context, id string, now time.Time) (bool, error)
+	GetTaskById(ctx context.Context, id string) (*FollowUpTask, error)
+	// GetTasks returns the matching tasks ordered by due date.
+	GetTasks(ctx context.Context, filter TaskFilter) ([]FollowUpTask, error)
+	// ClaimDigest records that the digest of the day was sent to the
+	// assignee and writes its task.digest event to the outbox in the same
+	// transaction. It returns false when it was already claimed, so running
+	// several instances sends a single digest.
+	ClaimDigest(ctx context.Context, digest TaskDigest) (bool, error)
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewFollowUpTaskInvalid(t *testing.T) {
+	due := time.Now().Add(time.Hour)
+	cases := map[string]struct {
+		talentId string
+		assignee string
+		dueAt    time.Time
+	}{
+		"missing talent":   {"", "ana", due},
+		"missing assignee": {"talent-1", "  ", due},
+		"missing due date": {"talent-1", "ana", time.Time{}},
+	}
+	for name, c := range cases {
+		_, err := NewFollowUpTask(c.talentId, c.assignee, "", c.dueAt)
+		if !errors.Is(err, ErrInvalidTask) {
+			t.Errorf("%s: expected ErrInvalidTask, got %v", name, err)
+		}
+	}
+}
+
+func TestFollowUpTaskMarkOverdueOnce(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	task, _ := NewFollowUpTask("talent-1", "ana", "call back", now.Add(-time.Minute))
+
+	if !task.MarkOverdue(now) {
+		t.Fatal("expected task past its due date to become overdue")
+	}
+	if task.MarkOverdue(now) {
+		t.Error("expected MarkOverdue to report no change the second time")
+	}
+
+	task.Reschedule(now.Add(24*time.Hour), now)
+	if task.Overdue {
+		t.Error("expected rescheduling to a future date to clear overdue")
+	}
+
+	task.Complete(now)
+	if task.MarkOverdue(now.Add(48 * time.Hour)) {
+		t.Error("expected done tasks never to become overdue")
+	}
+}
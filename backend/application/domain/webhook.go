@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	ErrInvalidWebhook          = errors.New("invalid webhook")
+)
+
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	// DeliveryDead marks deliveries that ran out of attempts (dead letter).
+	DeliveryDead = "dead"
+)
+
+type WebhookSubscription struct {
+	Id        uuid.UUID `firestore:"-"`
+	URL       string    `firestore:"url"`
+	Secret    string    `firestore:"secret"`
+	Events    []string  `firestore:"events"`
+	Active    bool      `firestore:"active"`
+	CreatedAt time.Time `firestore:"created_at"`
+}
+
+// NewWebhookSubscription generates a secret when none is given.
+func NewWebhookSubscription(rawURL string, secret string, events []string) (*WebhookSubscription, error) {
+	if secret == "" {
+		buf := make([]byte, 32)
+		_, err := rand.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		secret = hex.EncodeToString(buf)
+	}
+
+	subscription := &WebhookSubscription{
+		Id:        uuid.New(),
+		URL:       rawURL,
+		Secret:    secret,
+		Events:    events,
+		Active:    true,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err := subscription.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (s *WebhookSubscription) Validate() error {
+	u, err := url.Parse(s.URL)
+	if s.URL == "" || err != nil || u.Host == "" {
+		return fmt.Errorf("%w: url is invalid", ErrInvalidWebhook)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("%w: url must be http or https", ErrInvalidWebhook)
+	}
+	if len(s.Secret) < 16 {
+		return fmt.Errorf("%w: secret must have at least 16 characters", ErrInvalidWebhook)
+	}
+	if len(s.Events) == 0 {
+		return fmt.Errorf("%w: events is null", ErrInvalidWebhook)
+	}
+	for _, event := range s.Events {
+		if event != "*" && !slices.Contains(EventTypes, event) {
+			return fmt.Errorf("%w: event %s is unknown", ErrInvalidWebhook, event)
+		}
+	}
+	return nil
+}
+
+func (s *WebhookSubscription) Accepts(eventType string) bool {
+	return s.Active && (slices.Contains(s.Events, "*") || slices.Contains(s.Events, eventType))
+}
+
+type WebhookDelivery struct {
+	Id             uuid.UUID `firestore:"-"`
+	SubscriptionId string    `firestore:"subscription_id"`
+	EventId        string    `firestore:"event_id"`
+	EventType      string    `firestore:"event_type"`
+	Payload        []byte    `firestore:"payload"`
+	Status         string    `firestore:"status"`
+	Attempts       int       `firestore:"attempts"`
+	ResponseStatus int       `firestore:"response_status"`
+	LastError      string    `firestore:"last_error"`
+	CreatedAt      time.Time `firestore:"created_at"`
+	UpdatedAt      time.Time `firestore:"updated_at"`
+	NextAttemptAt  time.Time `firestore:"next_attempt_at"`
+}
+
+func NewWebhookDelivery(subscriptionId string, eventId string, eventType string, payload []byte) *WebhookDelivery {
+	now := time.Now().UTC()
+	return &WebhookDelivery{
+		Id:             uuid.New(),
+		SubscriptionId: subscriptionId,
+		EventId:        eventId,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         DeliveryPending,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		NextAttemptAt:  now,
+	}
+}
+
+// RecordAttempt registers the outcome of one attempt. A failed attempt is
+// retried at nextAttempt unless maxAttempts was reached, in which case the
+// delivery goes to the dead letter list.
+func (d *WebhookDelivery) RecordAttempt(responseStatus int, err error, maxAttempts int, nextAttempt time.Time) {
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.UpdatedAt = time.Now().UTC()
+
+	if err == nil {
+		d.Status = DeliveryDelivered
+		d.LastError = ""
+		return
+	}
+
+	d.LastError = err.Error()
+	if d.Attempts >= maxAttempts {
+		d.Status = DeliveryDead
+		return
+	}
+	d.NextAttemptAt = nextAttempt
+}
+
+// Requeue gives a dead delivery a new round of attempts.
+func (d *WebhookDelivery) Requeue() {
+	d.Status = DeliveryPending
+	d.Attempts = 0
+	d.NextAttemptAt = time.Now().UTC()
+	d.UpdatedAt = d.NextAttemptAt
+}
+
+type WebhookGateway interface {
+	SaveSubscription(ctx context.Context, subscription WebhookSubscription) error
+	GetSubscriptions(ctx context.Context) ([]WebhookSubscription, error)
+	GetSubscriptionById(ctx context.Context, id string) (*WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id string) error
+	SaveDelivery(ctx context.Context, delivery WebhookDelivery) error
+	GetDeliveryById(ctx context.Context, id string) (*WebhookDelivery, error)
+	// GetDeliveries lists the most recent deliveries, optionally filtered by
+	// subscription and status.
+	GetDeliveries(ctx context.Context, subscriptionId string, status string, limit int) ([]WebhookDelivery, error)
+	// GetDueDeliveries lists the pending deliveries whose next attempt is
+	// due at now, the longest waiting first.
+	GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]WebhookDelivery, error)
+}
+
+// WebhookQueue hands a stored delivery to the workers that send it.
+type WebhookQueue interface {
+	Enqueue(delivery WebhookDelivery)
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewWebhookSubscriptionGeneratesSecret(t *testing.T) {
+	subscription, err := NewWebhookSubscription("https://example.com/hook", "", []string{EventTalentCreated})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(subscription.Secret) != 64 {
+		t.Errorf("expected a 64 characters secret, got %q", subscription.Secret)
+	}
+	if !subscription.Active {
+		t.Error("expected subscription to be active")
+	}
+}
+
+func TestNewWebhookSubscriptionInvalid(t *testing.T) {
+	cases := map[string]struct {
+		url    string
+		secret string
+		events []string
+	}{
+		"missing url":   {"", "", []string{"*"}},
+		"ftp url":       {"ftp://example.com", "", []string{"*"}},
+		"short secret":  {"https://example.com", "short", []string{"*"}},
+		"no events":     {"https://example.com", "", nil},
+		"unknown event": {"https://example.com", "", []string{"talent.exploded"}},
+	}
+	for name, c := range cases {
+		_, err := NewWebhookSubscription(c.url, c.secret, c.events)
+		if !errors.Is(err, ErrInvalidWebhook) {
+			t.Errorf("%s: expected ErrInvalidWebhook, got %v", name, err)
+		}
+	}
+}
+
+func TestWebhookSubscriptionAccepts(t *testing.T) {
+	subscription, _ := NewWebhookSubscription("https://example.com/hook", "", []string{EventTalentCreated})
+	if !subscription.Accepts(EventTalentCreated) {
+		t.Error("expected talent.created to be accepted")
+	}
+	if subscription.Accepts(EventTalentDeleted) {
+		t.Error("expected talent.deleted to be filtered out")
+	}
+
+	subscription.Events = []string{"*"}
+	if !subscription.Accepts(EventTalentDeleted) {
+		t.Error("expected wildcard to accept every event")
+	}
+
+	subscription.Active = false
+	if subscription.Accepts(EventTalentCreated) {
+		t.Error("expected inactive subscription to accept nothing")
+	}
+}
+
+func TestWebhookDeliveryRecordAttempt(t *testing.T) {
+	delivery := NewWebhookDelivery("sub", "event", EventTalentCreated, []byte("{}"))
+	next := time.Now().Add(time.Minute)
+
+	delivery.RecordAttempt(500, errors.New("boom"), 2, next)
+	if delivery.Status != DeliveryPending || !delivery.NextAttemptAt.Equal(next) {
+		t.Fatalf("expected pending retry at %v, got %s at %v", next, delivery.Status, delivery.NextAttemptAt)
+	}
+
+	delivery.RecordAttempt(500, errors.New("boom"), 2, next)
+	if delivery.Status != DeliveryDead {
+		t.Fatalf("expected dead delivery, got %s", delivery.Status)
+	}
+
+	delivery.Requeue()
+	delivery.RecordAttempt(200, nil, 2, next)
+	if delivery.Status != DeliveryDelivered || delivery.Attempts != 1 || delivery.LastError != "" {
+		t.Errorf("unexpected delivery after requeue: %+v", delivery)
+	}
+}
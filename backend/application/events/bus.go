@@ -0,0 +1,129 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type Handler func(ctx context.Context, event domain.TalentEvent) error
+
+type Mode int
+
+const (
+	// Sync handlers run inside Publish, one after the other.
+	Sync Mode = iota
+	// Async handlers run on the bus workers, after Publish returned.
+	Async
+)
+
+type subscriber struct {
+	name    string
+	mode    Mode
+	handler Handler
+}
+
+type job struct {
+	ctx        context.Context
+	subscriber subscriber
+	event      domain.TalentEvent
+	handled    *sync.WaitGroup
+}
+
+// Bus is an in-process publisher/subscriber for talent events. Handler
+// errors are logged and never reach the publisher.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers []subscriber
+	workers     int
+	queue       chan job
+	wg          sync.WaitGroup
+}
+
+func NewBus(workers int, queueSize int) *Bus {
+	return &Bus{
+		workers: workers,
+		queue:   make(chan job, queueSize),
+	}
+}
+
+func (b *Bus) Subscribe(name string, mode Mode, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscribers = append(b.subscribers, subscriber{name: name, mode: mode, handler: handler})
+}
+
+// Publish blocks while the async queue is full, so a slow subscriber slows
+// the outbox relay down instead of losing events.
+func (b *Bus) Publish(ctx context.Context, event domain.TalentEvent) {
+	b.publish(ctx, event, nil)
+}
+
+// publish adds the async handlers of the event to handled, when it is not
+// nil, and reports false when ctx was done before they were all queued.
+func (b *Bus) publish(ctx context.Context, event domain.TalentEvent, handled *sync.WaitGroup) bool {
+	b.mu.RLock()
+	subscribers := b.subscribers
+	b.mu.RUnlock()
+
+	for _, s := range subscribers {
+		if s.mode == Sync {
+			b.handle(ctx, s, event)
+			continue
+		}
+		if handled != nil {
+			handled.Add(1)
+		}
+		select {
+		case b.queue <- job{ctx: context.WithoutCancel(ctx), subscriber: s, event: event, handled: handled}:
+		case <-ctx.Done():
+			if handled != nil {
+				handled.Done()
+			}
+			return false
+		}
+	}
+	return true
+}
+
+// Start runs the async workers until ctx is done. Wait blocks until they
+// have stopped.
+func (b *Bus) Start(ctx context.Context) {
+	for i := 0; i < b.workers; i++ {
+		b.wg.Add(1)
+		go func() {
+			defer b.wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case j := <-b.queue:
+					b.handle(j.ctx, j.subscriber, j.event)
+					if j.handled != nil {
+						j.handled.Done()
+					}
+				}
+			}
+		}()
+	}
+}
+
+func (b *Bus) Wait() {
+	b.wg.Wait()
+}
+
+func (b *Bus) handle(ctx context.Context, s subscriber, event domain.TalentEvent) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("event handler panicked", "subscriber", s.name, "event_id", event.Id, "panic", r)
+		}
+	}()
+
+	err := s.handler(ctx, event)
+	if err != nil {
+		logging.FromContext(ctx).Error("event handler failed", "subscriber", s.name, "event_id", event.Id, "event_type", event.Type, "error", err)
+	}
+}
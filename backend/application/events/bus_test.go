@@ -0,0 +1,241 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type InMemoryOutboxGateway struct {
+	mu     sync.Mutex
+	events []domain.TalentEvent
+}
+
+func (g *InMemoryOutboxGateway) GetPendingEvents(ctx context.Context, limit int) ([]domain.TalentEvent, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]domain.TalentEvent(nil), g.events[:min(limit, len(g.events))]...), nil
+}
+func (g *InMemoryOutboxGateway) MarkPublished(ctx context.Context, eventIds []string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	published := make(map[string]bool, len(eventIds))
+	for _, id := range eventIds {
+		published[id] = true
+	}
+	pending := g.events[:0]
+	for _, event := range g.events {
+		if !published[event.Id] {
+			pending = append(pending, event)
+		}
+	}
+	g.events = pending
+	return nil
+}
+
+func TestBusSyncHandlersRunBeforePublishReturns(t *testing.T) {
+	bus := NewBus(1, 10)
+	var received []string
+	bus.Subscribe("first", Sync, func(ctx context.Context, event domain.TalentEvent) error {
+		received = append(received, "first")
+		return errors.New("ignored")
+	})
+	bus.Subscribe("second", Sync, func(ctx context.Context, event domain.TalentEvent) error {
+		received = append(received, "second")
+		return nil
+	})
+
+	bus.Publish(context.Background(), domain.NewTalentEvent(domain.EventTalentCreated, "talent-1", nil))
+
+	if len(received) != 2 || received[0] != "first" || received[1] != "second" {
+		t.Errorf("expected both handlers in order even after an error, got %v", received)
+	}
+}
+
+func TestBusAsyncHandlersRunOnWorkers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := NewBus(2, 10)
+	received := make(chan domain.TalentEvent, 1)
+	bus.Subscribe("async", Async, func(ctx context.Context, event domain.TalentEvent) error {
+		received <- event
+		return nil
+	})
+	bus.Start(ctx)
+	defer func() {
+		cancel()
+		bus.Wait()
+	}()
+
+	requestCtx, cancelRequest := context.WithCancel(context.Background())
+	event := domain.NewTalentEvent(domain.EventTalentUpdated, "talent-1", nil)
+	bus.Publish(requestCtx, event)
+	cancelRequest()
+
+	select {
+	case got := <-received:
+		if got.Id != event.Id {
+			t.Errorf("expected event %s, got %s", event.Id, got.Id)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("async handler was not called")
+	}
+}
+
+func TestRelayPublishesAndClearsOutbox(t *testing.T) {
+	outbox := &InMemoryOutboxGateway{}
+	for range 5 {
+		outbox.events = append(outbox.events, domain.NewTalentEvent(domain.EventTalentCreated, "talent-1", nil))
+	}
+
+	bus := NewBus(1, 10)
+	var mu sync.Mutex
+	var published []string
+	bus.Subscribe("recorder", Sync, func(ctx context.Context, event domain.TalentEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		published = append(published, event.Id)
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	relay := NewRelay(outbox, bus, time.Hour, 2)
+	go relay.Run(ctx)
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		mu.Lock()
+		done := len(published) == 5
+		mu.Unlock()
+		if done {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(published) != 5 {
+		t.Fatalf("expected 5 published events, got %d", len(published))
+	}
+	if pending, _ := outbox.GetPendingEvents(ctx, 10); len(pending) != 0 {
+		t.Errorf("expected outbox to be empty, got %d events", len(pending))
+	}
+}
+
+func TestRelayWake(t *testing.T) {
+	outbox := &InMemoryOutboxGateway{}
+	bus := NewBus(1, 10)
+	published := make(chan string, 1)
+	bus.Subscribe("recorder", Sync, func(ctx context.Context, event domain.TalentEvent) error {
+		published <- event.Id
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	relay := NewRelay(outbox, bus, time.Hour, 10)
+	go relay.Run(ctx)
+
+	event := domain.NewTalentEvent(domain.EventTalentArchived, "talent-1", nil)
+	outbox.mu.Lock()
+	outbox.events = append(outbox.events, event)
+	outbox.mu.Unlock()
+	relay.Wake()
+
+	select {
+	case id := <-published:
+		if id != event.Id {
+			t.Errorf("expected event %s, got %s", event.Id, id)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("relay did not wake up before the next tick")
+	}
+}
+
+func TestRelayKeepsEventsQueuedAtShutdown(t *testing.T) {
+	outbox := &InMemoryOutboxGateway{}
+	for range 3 {
+		outbox.events = append(outbox.events, domain.NewTalentEvent(domain.EventTalentCreated, "talent-1", nil))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := NewBus(1, 10)
+	started := make(chan struct{}, 3)
+	release := make(chan struct{})
+	bus.Subscribe("slow", Async, func(ctx context.Context, event domain.TalentEvent) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	})
+	bus.Start(ctx)
+
+	relay := NewRelay(outbox, bus, time.Hour, 10)
+	relay.Start(ctx)
+	stopped := make(chan struct{})
+	go func() {
+		relay.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("async handler was not called")
+	}
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("relay did not stop")
+	}
+	close(release)
+	bus.Wait()
+
+	if pending, _ := outbox.GetPendingEvents(context.Background(), 10); len(pending) != 3 {
+		t.Errorf("expected the 3 unhandled events to stay in the outbox, got %d", len(pending))
+	}
+}
+
+func TestRelayMarksEventsAfterAsyncHandlers(t *testing.T) {
+	outbox := &InMemoryOutboxGateway{}
+	event := domain.NewTalentEvent(domain.EventTalentCreated, "talent-1", nil)
+	outbox.events = append(outbox.events, event)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := NewBus(1, 10)
+	handled := make(chan int, 1)
+	bus.Subscribe("async", Async, func(ctx context.Context, event domain.TalentEvent) error {
+		pending, _ := outbox.GetPendingEvents(ctx, 10)
+		handled <- len(pending)
+		return nil
+	})
+	bus.Start(ctx)
+	defer func() {
+		cancel()
+		bus.Wait()
+	}()
+	go NewRelay(outbox, bus, time.Hour, 10).Run(ctx)
+
+	select {
+	case pending := <-handled:
+		if pending != 1 {
+			t.Errorf("expected the event to be pending while handled, got %d pending", pending)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("async handler was not called")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if pending, _ := outbox.GetPendingEvents(ctx, 10); len(pending) == 0 {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Error("expected the event to be marked as published after its handler")
+}
@@ -0,0 +1,58 @@
+package events
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+// TalentGateway decorates a domain.TalentGateway waking the relay after
+// every successful write, so events reach the subscribers without waiting
+// for the next poll.
+type TalentGateway struct {
+	next  domain.TalentGateway
+	relay *Relay
+}
+
+func NewTalentGateway(next domain.TalentGateway, relay *Relay) *TalentGateway {
+	return &TalentGateway{
+		next:  next,
+		relay: relay,
+	}
+}
+
+func (g *TalentGateway) Save(ctx context.Context, talent *domain.Talent) error {
+	err := g.next.Save(ctx, talent)
+	if err == nil {
+		g.relay.Wake()
+	}
+	return err
+}
+
+func (g *TalentGateway) Delete(ctx context.Context, id string, version int64) error {
+	err := g.next.Delete(ctx, id, version)
+	if err == nil {
+		g.relay.Wake()
+	}
+	return err
+}
+
+func (g *TalentGateway) GetTalents(ctx context.Context, limit int, cursor string, sort domain.TalentSort) ([]domain.Talent, string, error) {
+	return g.next.GetTalents(ctx, limit, cursor, sort)
+}
+
+func (g *TalentGateway) GetTalentById(ctx context.Context, id string) (*domain.Talent, error) {
+	return g.next.GetTalentById(ctx, id)
+}
+
+func (g *TalentGateway) GetTalentsByTags(ctx context.Context, tags []string, limit int) ([]domain.Talent, error) {
+	return g.next.GetTalentsByTags(ctx, tags, limit)
+}
+
+func (g *TalentGateway) FindTalentsByContact(ctx context.Context, keys []string, limit int) ([]domain.Talent, error) {
+	return g.next.FindTalentsByContact(ctx, keys, limit)
+}
+
+func (g *TalentGateway) Ping(ctx context.Context) error {
+	return g.next.Ping(ctx)
+}
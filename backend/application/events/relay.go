@@ -0,0 +1,121 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+// Relay moves events from the outbox to the bus. Events are marked as
+// published only after all their handlers ran, async ones included, so a
+// shutdown or crash in between delivers them again: subscribers must
+// tolerate duplicates.
+type Relay struct {
+	outbox    domain.OutboxGateway
+	bus       *Bus
+	interval  time.Duration
+	batchSize int
+	wake      chan struct{}
+	wg        sync.WaitGroup
+}
+
+func NewRelay(outbox domain.OutboxGateway, bus *Bus, interval time.Duration, batchSize int) *Relay {
+	return &Relay{
+		outbox:    outbox,
+		bus:       bus,
+		interval:  interval,
+		batchSize: batchSize,
+		wake:      make(chan struct{}, 1),
+	}
+}
+
+// Wake asks the relay to look at the outbox now instead of waiting for the
+// next tick.
+func (r *Relay) Wake() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start runs the relay in the background until ctx is done. Wait blocks
+// until it has stopped.
+func (r *Relay) Start(ctx context.Context) {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.Run(ctx)
+	}()
+}
+
+func (r *Relay) Wait() {
+	r.wg.Wait()
+}
+
+// Run polls the outbox until ctx is done.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.drain(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.wake:
+		}
+	}
+}
+
+func (r *Relay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		events, err := r.outbox.GetPendingEvents(ctx, r.batchSize)
+		if err != nil {
+			slog.Error("outbox error: could not load pending events", "error", err)
+			return
+		}
+		if len(events) == 0 {
+			return
+		}
+
+		var handled sync.WaitGroup
+		ids := make([]string, 0, len(events))
+		for _, event := range events {
+			if !r.bus.publish(ctx, event, &handled) {
+				return
+			}
+			ids = append(ids, event.Id)
+		}
+		if !waitHandled(ctx, &handled) {
+			return
+		}
+		err = r.outbox.MarkPublished(ctx, ids)
+		if err != nil {
+			slog.Error("outbox error: could not mark events as published", "count", len(ids), "error", err)
+			return
+		}
+		if len(events) < r.batchSize {
+			return
+		}
+	}
+}
+
+// waitHandled reports false when ctx is done before the handlers finished,
+// the bus workers stop then and the events stay in the outbox.
+func waitHandled(ctx context.Context, handled *sync.WaitGroup) bool {
+	done := make(chan struct{})
+	go func() {
+		handled.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
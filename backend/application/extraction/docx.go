@@ -0,0 +1,73 @@
+package extraction
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// maxDocumentXML bounds the uncompressed document part, so a zip bomb does
+// not exhaust memory.
+const maxDocumentXML = 32 << 20
+
+const wordNamespace = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+
+// extractDOCX reads the runs of word/document.xml. Paragraphs, breaks and
+// table cells end a line and tabs become spaces.
+func extractDOCX(content []byte) (string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "", err
+	}
+	file, err := archive.Open("word/document.xml")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	decoder := xml.NewDecoder(io.LimitReader(file, maxDocumentXML))
+	var text strings.Builder
+	inText := false
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space != wordNamespace {
+				continue
+			}
+			switch t.Name.Local {
+			case "t":
+				inText = true
+			case "tab":
+				text.WriteByte(' ')
+			case "br", "cr":
+				text.WriteByte('\n')
+			}
+		case xml.EndElement:
+			if t.Name.Space != wordNamespace {
+				continue
+			}
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p", "tc":
+				text.WriteByte('\n')
+			}
+		case xml.CharData:
+			if inText {
+				text.Write(t)
+			}
+		}
+	}
+	return text.String(), nil
+}
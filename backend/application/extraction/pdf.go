@@ -0,0 +1,110 @@
+package extraction
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// maxPDFPages bounds the work on a single document. CVs have a few pages,
+// longer files are usually portfolios whose first pages say enough.
+const maxPDFPages = 30
+
+// tjSpace is the TJ adjustment, in thousandths of an em, above which a gap
+// is taken as a space. Many generators position words instead of writing
+// the space character.
+const tjSpace = 200
+
+// extractPDF walks the text operators of every page. The pdf package panics
+// on malformed files, so panics become errors here.
+func extractPDF(content []byte) (text string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed pdf: %v", r)
+		}
+	}()
+
+	reader, err := pdf.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "", err
+	}
+
+	var builder strings.Builder
+	for i := 1; i <= min(reader.NumPage(), maxPDFPages); i++ {
+		page := reader.Page(i)
+		if page.V.IsNull() || page.V.Key("Contents").IsNull() {
+			continue
+		}
+		pageText(&builder, page)
+		builder.WriteByte('\n')
+	}
+	return builder.String(), nil
+}
+
+func pageText(builder *strings.Builder, page pdf.Page) {
+	fonts := make(map[string]*pdf.Font)
+	var encoding pdf.TextEncoding
+	show := func(raw string) {
+		if encoding == nil {
+			builder.WriteString(raw)
+			return
+		}
+		builder.WriteString(encoding.Decode(raw))
+	}
+
+	pdf.Interpret(page.V.Key("Contents"), func(stack *pdf.Stack, op string) {
+		args := make([]pdf.Value, stack.Len())
+		for i := len(args) - 1; i >= 0; i-- {
+			args[i] = stack.Pop()
+		}
+
+		switch op {
+		case "BT", "T*", "Tm":
+			builder.WriteByte('\n')
+		case "Td", "TD":
+			// a vertical move starts a new line, a horizontal one a new word
+			if len(args) == 2 && args[1].Float64() != 0 {
+				builder.WriteByte('\n')
+			} else {
+				builder.WriteByte(' ')
+			}
+		case "Tf":
+			if len(args) != 2 {
+				return
+			}
+			name := args[0].Name()
+			font, found := fonts[name]
+			if !found {
+				f := page.Font(name)
+				font = &f
+				fonts[name] = font
+			}
+			encoding = font.Encoder()
+		case "Tj", "'", "\"":
+			if len(args) == 0 {
+				return
+			}
+			if op != "Tj" {
+				builder.WriteByte('\n')
+			}
+			show(args[len(args)-1].RawString())
+		case "TJ":
+			if len(args) != 1 {
+				return
+			}
+			for i := 0; i < args[0].Len(); i++ {
+				item := args[0].Index(i)
+				switch item.Kind() {
+				case pdf.String:
+					show(item.RawString())
+				case pdf.Integer, pdf.Real:
+					if -item.Float64() > tjSpace {
+						builder.WriteByte(' ')
+					}
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package extraction
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+// ErrUnreadable is returned for documents no text can be read from. Trying
+// again would fail the same way, unlike storage errors.
+var ErrUnreadable = errors.New("could not read the document")
+
+// MaxTextBytes bounds the stored text. A CV rarely passes a few dozen
+// kilobytes, anything much larger is not worth indexing in full.
+const MaxTextBytes = 512 << 10
+
+// Extract returns the text of a PDF, DOCX or plain text document, with
+// blank lines and repeated spaces removed.
+func Extract(contentType string, content []byte) (string, error) {
+	var text string
+	var err error
+	switch contentType {
+	case domain.ContentTypePDF:
+		text, err = extractPDF(content)
+	case domain.ContentTypeDOCX:
+		text, err = extractDOCX(content)
+	case domain.ContentTypeText:
+		text = strings.ToValidUTF8(string(content), "")
+	default:
+		return "", fmt.Errorf("%w: no text in %s", ErrUnreadable, contentType)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrUnreadable, err)
+	}
+
+	text = clean(text)
+	if text == "" {
+		// scanned documents are images, there is no OCR
+		return "", fmt.Errorf("%w: no text found", ErrUnreadable)
+	}
+	return text, nil
+}
+
+func clean(text string) string {
+	var lines []string
+	size := 0
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.Join(strings.FieldsFunc(line, func(r rune) bool {
+			return unicode.IsSpace(r) || unicode.IsControl(r)
+		}), " ")
+		if line == "" {
+			continue
+		}
+		size += len(line) + 1
+		if size > MaxTextBytes {
+			break
+		}
+		lines = append(lines, line)
+	}
+	text = strings.Join(lines, "\n")
+	if !utf8.ValidString(text) {
+		text = strings.ToValidUTF8(text, "")
+	}
+	return text
+}
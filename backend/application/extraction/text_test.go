@@ -0,0 +1,111 @@
+package extraction
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+// buildPDF writes a one page PDF showing content with Helvetica.
+func buildPDF(content string) []byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << /Font << /F1 4 0 R >> >> /Contents 5 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+func buildDOCX(document string) []byte {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	part, _ := archive.Create("word/document.xml")
+	_, _ = part.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>` +
+		`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` + document + `</w:body></w:document>`))
+	_ = archive.Close()
+	return buf.Bytes()
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		content     []byte
+		want        string
+	}{
+		{
+			name:        "text",
+			contentType: domain.ContentTypeText,
+			content:     []byte("Ana Souza\r\n\r\n  Engenheira   de dados\n"),
+			want:        "Ana Souza\nEngenheira de dados",
+		},
+		{
+			name:        "docx",
+			contentType: domain.ContentTypeDOCX,
+			content: buildDOCX(`<w:p><w:r><w:t>Ana</w:t></w:r><w:r><w:tab/><w:t xml:space="preserve">Souza</w:t></w:r></w:p>` +
+				`<w:p><w:r><w:t>Go</w:t><w:br/><w:t>Kubernetes</w:t></w:r></w:p>`),
+			want: "Ana Souza\nGo\nKubernetes",
+		},
+		{
+			name:        "pdf",
+			contentType: domain.ContentTypePDF,
+			content: buildPDF("BT /F1 12 Tf 72 720 Td (Ana Souza) Tj 0 -14 Td [(Go)-300(e)-250(Kubernetes)] TJ ET\n" +
+				"BT /F1 12 Tf 72 680 Td (Experi\\352ncia) Tj ET"),
+			want: "Ana Souza\nGo e Kubernetes\nExperiência",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := Extract(tt.contentType, tt.content)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if text != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, text)
+			}
+		})
+	}
+}
+
+func TestExtractUnreadable(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		content     []byte
+	}{
+		{"image", domain.ContentTypePNG, []byte("\x89PNG\r\n\x1a\n")},
+		{"broken pdf", domain.ContentTypePDF, []byte("%PDF-1.7\nnot really")},
+		{"pdf without text", domain.ContentTypePDF, buildPDF("0 0 612 792 re f")},
+		{"zip without document", domain.ContentTypeDOCX, []byte("PK\x05\x06" + strings.Repeat("\x00", 18))},
+		{"blank text", domain.ContentTypeText, []byte(" \n\t\n")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Extract(tt.contentType, tt.content)
+			if !errors.Is(err, ErrUnreadable) {
+				t.Errorf("expected ErrUnreadable, got %v", err)
+			}
+		})
+	}
+}
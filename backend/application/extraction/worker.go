@@ -0,0 +1,234 @@
+package extraction
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+// keywordRetries is how many times a talent is reloaded when another write
+// raced the keyword update.
+const keywordRetries = 3
+
+// Worker extracts the text of uploaded attachments in the background. The
+// text is stored next to the content, its words feed the talent search and
+// the tags found in it are suggested on the attachment. Attachments left
+// pending by a restart or a full queue are resumed by a rescan every
+// interval.
+type Worker struct {
+	attachments domain.AttachmentGateway
+	blobs       domain.BlobStore
+	talents     domain.TalentGateway
+	suggester   domain.TagSuggester
+	clock       domain.Clock
+	workers     int
+	interval    time.Duration
+	queue       chan domain.Attachment
+	mu          sync.Mutex
+	queued      map[string]bool
+	wg          sync.WaitGroup
+}
+
+func NewWorker(attachments domain.AttachmentGateway, blobs domain.BlobStore, talents domain.TalentGateway, suggester domain.TagSuggester,
+	clock domain.Clock, workers int, queueSize int, interval time.Duration) *Worker {
+	return &Worker{
+		attachments: attachments,
+		blobs:       blobs,
+		talents:     talents,
+		suggester:   suggester,
+		clock:       clock,
+		workers:     workers,
+		interval:    interval,
+		queue:       make(chan domain.Attachment, queueSize),
+		queued:      make(map[string]bool),
+	}
+}
+
+// Enqueue never blocks and ignores attachments already queued. When the
+// queue is full the attachment stays pending in storage and is picked up by
+// the next rescan.
+func (w *Worker) Enqueue(attachment domain.Attachment) {
+	id := attachment.Id.String()
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.queued[id] {
+		return
+	}
+	select {
+	case w.queue <- attachment:
+		w.queued[id] = true
+	default:
+		slog.Warn("extraction queue full, attachment left pending", "attachment_id", id)
+	}
+}
+
+// Start runs the workers and rescans pending attachments every interval
+// until ctx is done. Wait blocks until they have stopped.
+func (w *Worker) Start(ctx context.Context) {
+	for i := 0; i < w.workers; i++ {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.work(ctx)
+		}()
+	}
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.rescan(ctx)
+	}()
+}
+
+func (w *Worker) rescan(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.resume(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) resume(ctx context.Context) {
+	if len(w.queue) == cap(w.queue) {
+		return
+	}
+	pending, err := w.attachments.GetPendingAttachments(ctx, cap(w.queue))
+	if err != nil {
+		slog.Error("extraction error: could not resume pending attachments", "error", err)
+		return
+	}
+	for _, attachment := range pending {
+		w.Enqueue(attachment)
+	}
+}
+
+func (w *Worker) Wait() {
+	w.wg.Wait()
+}
+
+func (w *Worker) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case attachment := <-w.queue:
+			w.Process(ctx, attachment)
+			w.mu.Lock()
+			delete(w.queued, attachment.Id.String())
+			w.mu.Unlock()
+		}
+	}
+}
+
+// Process extracts the text of a pending attachment. Unreadable documents
+// are marked as failed; storage errors leave the attachment pending so it is
+// tried again on the next rescan.
+func (w *Worker) Process(ctx context.Context, attachment domain.Attachment) {
+	logger := slog.Default().With("attachment_id", attachment.Id.String(), "talent_id", attachment.TalentId)
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("extraction panicked", "panic", r)
+		}
+	}()
+	if attachment.TextStatus != domain.TextPending {
+		return
+	}
+
+	text, err := w.text(ctx, attachment)
+	if errors.Is(err, ErrUnreadable) {
+		attachment.RecordTextFailure(err, w.clock.Now().UTC())
+		w.save(ctx, logger, attachment)
+		logger.Warn("attachment text could not be extracted", "content_type", attachment.ContentType, "error", err)
+		return
+	}
+	if err != nil {
+		logger.Error("extraction error: could not read attachment", "error", err)
+		return
+	}
+
+	tags, err := w.suggester.SuggestTags(ctx, text)
+	if err != nil {
+		logger.Error("extraction error: could not suggest tags", "error", err)
+		return
+	}
+	err = w.addKeywords(ctx, attachment.TalentId, domain.SearchKeywords(text, domain.MaxCVKeywords))
+	if err != nil && !errors.Is(err, domain.ErrTalentNotFound) {
+		logger.Error("extraction error: could not update talent keywords", "error", err)
+		return
+	}
+
+	attachment.RecordText(text, tags, w.clock.Now().UTC())
+	w.save(ctx, logger, attachment)
+	logger.Info("attachment text extracted", "length", attachment.TextLength, "suggested_tags", len(tags))
+}
+
+// text reuses the text extracted from an earlier upload of the same file.
+func (w *Worker) text(ctx context.Context, attachment domain.Attachment) (string, error) {
+	stored, err := w.blobs.Get(ctx, attachment.TextBlobKey())
+	if err == nil {
+		defer stored.Close()
+		text, err := io.ReadAll(stored)
+		return string(text), err
+	}
+	if !errors.Is(err, domain.ErrBlobNotFound) {
+		return "", err
+	}
+
+	content, err := w.blobs.Get(ctx, attachment.BlobKey())
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return "", err
+	}
+
+	text, err := Extract(attachment.ContentType, data)
+	if err != nil {
+		return "", err
+	}
+	err = w.blobs.Put(ctx, attachment.TextBlobKey(), bytes.NewReader([]byte(text)), domain.ContentTypeText)
+	if err != nil {
+		return "", err
+	}
+	return text, nil
+}
+
+func (w *Worker) addKeywords(ctx context.Context, talentId string, keywords []string) error {
+	var err error
+	for range keywordRetries {
+		var talent *domain.Talent
+		talent, err = w.talents.GetTalentById(ctx, talentId)
+		if err != nil {
+			return err
+		}
+		if !talent.AddCVKeywords(keywords) {
+			return nil
+		}
+		err = w.talents.Save(ctx, talent)
+		if !errors.Is(err, domain.ErrVersionConflict) {
+			return err
+		}
+	}
+	return err
+}
+
+func (w *Worker) save(ctx context.Context, logger *slog.Logger, attachment domain.Attachment) {
+	err := w.attachments.SaveAttachment(ctx, attachment)
+	if err != nil {
+		logger.Error("extraction error: could not store attachment", "error", err)
+	}
+}
@@ -0,0 +1,210 @@
+package extraction
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type stubAttachmentGateway struct {
+	domain.AttachmentGateway
+	mu      sync.Mutex
+	pending []domain.Attachment
+	saved   []domain.Attachment
+}
+
+func (g *stubAttachmentGateway) SaveAttachment(ctx context.Context, attachment domain.Attachment) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.saved = append(g.saved, attachment)
+	g.pending = slices.DeleteFunc(g.pending, func(pending domain.Attachment) bool {
+		return pending.Id == attachment.Id && attachment.TextStatus != domain.TextPending
+	})
+	return nil
+}
+func (g *stubAttachmentGateway) GetPendingAttachments(ctx context.Context, limit int) ([]domain.Attachment, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return slices.Clone(g.pending[:min(limit, len(g.pending))]), nil
+}
+
+type stubBlobStore struct {
+	blobs map[string][]byte
+	puts  int
+}
+
+func (s *stubBlobStore) Put(ctx context.Context, key string, content io.Reader, contentType string) error {
+	data, err := io.ReadAll(content)
+	s.blobs[key] = data
+	s.puts++
+	return err
+}
+func (s *stubBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, exists := s.blobs[key]
+	if !exists {
+		return nil, domain.ErrBlobNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+func (s *stubBlobStore) Exists(ctx context.Context, key string) (bool, error) {
+	_, exists := s.blobs[key]
+	return exists, nil
+}
+
+type stubTalentGateway struct {
+	domain.TalentGateway
+	talent    domain.Talent
+	conflicts int
+}
+
+func (g *stubTalentGateway) GetTalentById(ctx context.Context, id string) (*domain.Talent, error) {
+	if g.talent.Id.String() != id {
+		return nil, domain.ErrTalentNotFound
+	}
+	talent := g.talent
+	talent.CVKeywords = slices.Clone(g.talent.CVKeywords)
+	return &talent, nil
+}
+func (g *stubTalentGateway) Save(ctx context.Context, talent *domain.Talent) error {
+	if g.conflicts > 0 {
+		g.conflicts--
+		return domain.ErrVersionConflict
+	}
+	g.talent = *talent
+	return nil
+}
+
+type stubSuggester struct{}
+
+func (stubSuggester) SuggestTags(ctx context.Context, texts ...string) ([]string, error) {
+	senior, _ := domain.NewTag("Senior", domain.TagCategorySeniority, nil)
+	return domain.NewTagExtractor([]domain.Tag{*senior}).Extract(texts...), nil
+}
+
+type fixedClock struct{}
+
+func (fixedClock) Now() time.Time {
+	return time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+}
+
+func newTestWorker(t *testing.T) (*Worker, *stubAttachmentGateway, *stubBlobStore, *stubTalentGateway) {
+	t.Helper()
+	talent, err := domain.Create("https://linkedin.com/in/ana", "Data Engineer", "Ana Souza", "Data", "Acme", "Data Engineer", nil, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	attachments := &stubAttachmentGateway{}
+	blobs := &stubBlobStore{blobs: make(map[string][]byte)}
+	talents := &stubTalentGateway{talent: *talent}
+	return NewWorker(attachments, blobs, talents, stubSuggester{}, fixedClock{}, 1, 10, time.Minute), attachments, blobs, talents
+}
+
+func upload(t *testing.T, blobs *stubBlobStore, talentId string, contentType string, content []byte) domain.Attachment {
+	t.Helper()
+	sum := sha256.Sum256(content)
+	attachment, err := domain.NewAttachment(talentId, domain.AttachmentKindCV, "cv", contentType, int64(len(content)), hex.EncodeToString(sum[:]))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	blobs.blobs[attachment.BlobKey()] = content
+	return *attachment
+}
+
+func TestWorkerProcess(t *testing.T) {
+	worker, attachments, blobs, talents := newTestWorker(t)
+	talents.conflicts = 1
+	content := []byte("Ana Souza\nSênior em Airflow e Spark, 8 anos")
+	attachment := upload(t, blobs, talents.talent.Id.String(), domain.ContentTypeText, content)
+
+	worker.Process(context.Background(), attachment)
+
+	if len(attachments.saved) != 1 {
+		t.Fatalf("expected the attachment to be saved once, got %d", len(attachments.saved))
+	}
+	saved := attachments.saved[0]
+	if saved.TextStatus != domain.TextDone || saved.TextLength != 43 || !saved.ExtractedAt.Equal(fixedClock{}.Now()) {
+		t.Errorf("unexpected text status %+v", saved)
+	}
+	if !slices.Equal(saved.SuggestedTags, []string{"Senior"}) {
+		t.Errorf("expected the seniority to be suggested, got %v", saved.SuggestedTags)
+	}
+	if string(blobs.blobs[attachment.TextBlobKey()]) != string(content) {
+		t.Errorf("expected the text to be stored, got %q", blobs.blobs[attachment.TextBlobKey()])
+	}
+	want := []string{"ana", "souza", "senior", "airflow", "spark", "anos"}
+	if !slices.Equal(talents.talent.CVKeywords, want) {
+		t.Errorf("expected keywords %v after the version conflict, got %v", want, talents.talent.CVKeywords)
+	}
+	if !talents.talent.MatchesSearch(domain.SearchKeywords("airflow spa", 0)) {
+		t.Error("expected the talent to be found by the CV text")
+	}
+
+	// the same file uploaded again reuses the stored text
+	puts := blobs.puts
+	worker.Process(context.Background(), upload(t, blobs, talents.talent.Id.String(), domain.ContentTypeText, content))
+	if blobs.puts != puts || attachments.saved[1].TextStatus != domain.TextDone {
+		t.Errorf("expected the text to be reused, got %d writes and %+v", blobs.puts-puts, attachments.saved[1])
+	}
+}
+
+func TestWorkerProcessUnreadable(t *testing.T) {
+	worker, attachments, blobs, talents := newTestWorker(t)
+	attachment := upload(t, blobs, talents.talent.Id.String(), domain.ContentTypePDF, []byte("%PDF-1.7\nbroken"))
+
+	worker.Process(context.Background(), attachment)
+
+	if len(attachments.saved) != 1 || attachments.saved[0].TextStatus != domain.TextFailed || attachments.saved[0].TextError == "" {
+		t.Fatalf("expected the attachment to be marked as failed, got %+v", attachments.saved)
+	}
+	if len(talents.talent.CVKeywords) != 0 {
+		t.Errorf("expected no keywords, got %v", talents.talent.CVKeywords)
+	}
+}
+
+func TestWorkerProcessLeavesPendingOnStorageErrors(t *testing.T) {
+	worker, attachments, blobs, talents := newTestWorker(t)
+	attachment := upload(t, blobs, talents.talent.Id.String(), domain.ContentTypeText, []byte("Go"))
+	delete(blobs.blobs, attachment.BlobKey())
+
+	worker.Process(context.Background(), attachment)
+
+	if len(attachments.saved) != 0 {
+		t.Errorf("expected the attachment to stay pending, got %+v", attachments.saved)
+	}
+}
+
+func TestWorkerRescansAttachmentsLeftPending(t *testing.T) {
+	worker, attachments, blobs, talents := newTestWorker(t)
+	worker = NewWorker(attachments, blobs, talents, stubSuggester{}, fixedClock{}, 1, 1, 10*time.Millisecond)
+	for i := range 5 {
+		content := []byte{byte('a' + i)}
+		attachments.pending = append(attachments.pending, upload(t, blobs, talents.talent.Id.String(), domain.ContentTypeText, content))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	worker.Start(ctx)
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		attachments.mu.Lock()
+		left := len(attachments.pending)
+		attachments.mu.Unlock()
+		if left == 0 {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	cancel()
+	worker.Wait()
+
+	if len(attachments.pending) != 0 {
+		t.Errorf("expected every attachment to be processed, %d left pending", len(attachments.pending))
+	}
+}
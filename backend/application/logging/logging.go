@@ -0,0 +1,63 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+type loggerKey struct{}
+
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the request scoped logger, or the default logger when
+// the context does not carry one (background jobs, tests).
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// New builds a logger writing either JSON, using the field names Cloud
+// Logging understands, or plain text for local development.
+func New(w io.Writer, level string, format string) (*slog.Logger, error) {
+	var lvl slog.Level
+	err := lvl.UnmarshalText([]byte(level))
+	if err != nil {
+		return nil, fmt.Errorf("invalid log level %q", level)
+	}
+
+	options := &slog.HandlerOptions{
+		Level:       lvl,
+		ReplaceAttr: cloudLoggingAttr,
+	}
+	switch strings.ToLower(format) {
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, options)), nil
+	case "text":
+		return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: lvl})), nil
+	default:
+		return nil, fmt.Errorf("invalid log format %q", format)
+	}
+}
+
+func cloudLoggingAttr(groups []string, attr slog.Attr) slog.Attr {
+	if len(groups) > 0 {
+		return attr
+	}
+	switch attr.Key {
+	case slog.LevelKey:
+		attr.Key = "severity"
+		if level, ok := attr.Value.Any().(slog.Level); ok && level == slog.LevelWarn {
+			attr.Value = slog.StringValue("WARNING")
+		}
+	case slog.MessageKey:
+		attr.Key = "message"
+	}
+	return attr
+}
@@ -0,0 +1,52 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+func TestNewJSONUsesCloudLoggingFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, "info", "json")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	logger.Warn("disk almost full")
+
+	var entry map[string]any
+	err = json.Unmarshal(buf.Bytes(), &entry)
+	if err != nil {
+		t.Fatalf("expected JSON output, got %s", buf.String())
+	}
+
+	if entry["severity"] != "WARNING" {
+		t.Errorf("expected severity WARNING, got %v", entry["severity"])
+	}
+
+	if entry["message"] != "disk almost full" {
+		t.Errorf("expected message 'disk almost full', got %v", entry["message"])
+	}
+}
+
+func TestNewInvalidLevel(t *testing.T) {
+	_, err := New(&bytes.Buffer{}, "loud", "json")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if FromContext(context.Background()) != slog.Default() {
+		t.Error("expected default logger for an empty context")
+	}
+
+	logger := slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))
+	ctx := WithLogger(context.Background(), logger)
+	if FromContext(ctx) != logger {
+		t.Error("expected logger stored in the context")
+	}
+}
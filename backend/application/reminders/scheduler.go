@@ -0,0 +1,149 @@
+package reminders
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+// Waker is told that events were written to the outbox, so they are relayed
+// without waiting for the next poll.
+type Waker interface {
+	Wake()
+}
+
+// Scheduler flags overdue follow-up tasks and, once a day after digestHour
+// (UTC), sends a digest per assignee with their overdue tasks and the ones
+// due that day. The events go through the outbox, written together with the
+// overdue flag or the digest claim, so a shutdown does not lose them.
+type Scheduler struct {
+	tasks      domain.TaskGateway
+	outbox     Waker
+	clock      domain.Clock
+	interval   time.Duration
+	digestHour int
+	wg         sync.WaitGroup
+}
+
+func NewScheduler(tasks domain.TaskGateway, outbox Waker, clock domain.Clock, interval time.Duration, digestHour int) *Scheduler {
+	return &Scheduler{
+		tasks:      tasks,
+		outbox:     outbox,
+		clock:      clock,
+		interval:   interval,
+		digestHour: digestHour,
+	}
+}
+
+// Start runs the scheduler in the background until ctx is done. Wait blocks
+// until it has stopped.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.Run(ctx)
+	}()
+}
+
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// Run calls Tick every interval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.Tick(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) Tick(ctx context.Context) {
+	now := s.clock.Now().UTC()
+	endOfDay := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+
+	open := false
+	tasks, err := s.tasks.GetTasks(ctx, domain.TaskFilter{Done: &open, DueBefore: endOfDay})
+	if err != nil {
+		logging.FromContext(ctx).Error("scheduler error: could not load open tasks", "error", err)
+		return
+	}
+
+	tasks = s.markOverdue(ctx, tasks, now)
+	if now.Hour() >= s.digestHour {
+		s.sendDigests(ctx, tasks, now)
+	}
+}
+
+// markOverdue returns the tasks still open, as tasks may be completed after
+// the scan.
+func (s *Scheduler) markOverdue(ctx context.Context, tasks []domain.FollowUpTask, now time.Time) []domain.FollowUpTask {
+	logger := logging.FromContext(ctx)
+	open := tasks[:0]
+	for _, task := range tasks {
+		if !task.MarkOverdue(now) {
+			open = append(open, task)
+			continue
+		}
+		marked, err := s.tasks.MarkTaskOverdue(ctx, task.Id.String(), now)
+		if err != nil {
+			logger.Error("scheduler error: could not flag overdue task", "task_id", task.Id.String(), "error", err)
+			open = append(open, task)
+			continue
+		}
+		if !marked {
+			stored, err := s.tasks.GetTaskById(ctx, task.Id.String())
+			if err == nil && !stored.Done {
+				open = append(open, *stored)
+			}
+			continue
+		}
+		open = append(open, task)
+		s.outbox.Wake()
+		logger.Info("task overdue", "task_id", task.Id.String(), "assignee", task.Assignee)
+	}
+	return open
+}
+
+func (s *Scheduler) sendDigests(ctx context.Context, tasks []domain.FollowUpTask, now time.Time) {
+	logger := logging.FromContext(ctx)
+	day := now.Format(time.DateOnly)
+	digests := make(map[string]*domain.TaskDigest)
+	var assignees []string
+	for _, task := range tasks {
+		digest, ok := digests[task.Assignee]
+		if !ok {
+			digest = &domain.TaskDigest{Assignee: task.Assignee, Day: day}
+			digests[task.Assignee] = digest
+			assignees = append(assignees, task.Assignee)
+		}
+		if task.Overdue {
+			digest.Overdue = append(digest.Overdue, task)
+		} else {
+			digest.DueToday = append(digest.DueToday, task)
+		}
+	}
+
+	for _, assignee := range assignees {
+		digest := digests[assignee]
+		claimed, err := s.tasks.ClaimDigest(ctx, *digest)
+		if err != nil {
+			logger.Error("scheduler error: could not claim digest", "assignee", assignee, "error", err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		s.outbox.Wake()
+		logger.Info("task digest sent", "assignee", assignee, "day", day, "overdue", len(digest.Overdue), "due_today", len(digest.DueToday))
+	}
+}
@@ -0,0 +1,189 @@
+package reminders
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c *fixedClock) Now() time.Time {
+	return c.now
+}
+
+type InMemoryTaskGateway struct {
+	tasks  map[string]domain.FollowUpTask
+	claims map[string]bool
+	outbox []domain.TalentEvent
+	// beforeMark runs before MarkTaskOverdue, to change the task after the scan
+	beforeMark func(id string)
+}
+
+func NewInMemoryTaskGateway() *InMemoryTaskGateway {
+	return &InMemoryTaskGateway{
+		tasks:  make(map[string]domain.FollowUpTask),
+		claims: make(map[string]bool),
+	}
+}
+
+func (g *InMemoryTaskGateway) SaveTask(ctx context.Context, task domain.FollowUpTask) error {
+	g.tasks[task.Id.String()] = task
+	return nil
+}
+func (g *InMemoryTaskGateway) MarkTaskOverdue(ctx context.Context, id string, now time.Time) (bool, error) {
+	if g.beforeMark != nil {
+		g.beforeMark(id)
+	}
+	task, exists := g.tasks[id]
+	if !exists || !task.MarkOverdue(now) {
+		return false, nil
+	}
+	g.tasks[id] = task
+	g.outbox = append(g.outbox, domain.NewTaskOverdueEvent(task))
+	return true, nil
+}
+func (g *InMemoryTaskGateway) GetTaskById(ctx context.Context, id string) (*domain.FollowUpTask, error) {
+	if task, exists := g.tasks[id]; exists {
+		return &task, nil
+	}
+	return nil, domain.ErrTaskNotFound
+}
+func (g *InMemoryTaskGateway) GetTasks(ctx context.Context, filter domain.TaskFilter) ([]domain.FollowUpTask, error) {
+	var tasks []domain.FollowUpTask
+	for _, task := range g.tasks {
+		if filter.Assignee != "" && task.Assignee != filter.Assignee {
+			continue
+		}
+		if filter.Done != nil && task.Done != *filter.Done {
+			continue
+		}
+		if !filter.DueBefore.IsZero() && !task.DueAt.Before(filter.DueBefore) {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	slices.SortFunc(tasks, func(a, b domain.FollowUpTask) int { return a.DueAt.Compare(b.DueAt) })
+	return tasks, nil
+}
+func (g *InMemoryTaskGateway) ClaimDigest(ctx context.Context, digest domain.TaskDigest) (bool, error) {
+	key := digest.Day + "/" + digest.Assignee
+	if g.claims[key] {
+		return false, nil
+	}
+	g.claims[key] = true
+	g.outbox = append(g.outbox, domain.NewTaskDigestEvent(digest))
+	return true, nil
+}
+
+func (g *InMemoryTaskGateway) ofType(eventType string) []domain.TalentEvent {
+	var events []domain.TalentEvent
+	for _, event := range g.outbox {
+		if event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+type countingWaker struct {
+	wakes int
+}
+
+func (w *countingWaker) Wake() {
+	w.wakes++
+}
+
+func addTask(t *testing.T, gateway *InMemoryTaskGateway, assignee string, dueAt time.Time) domain.FollowUpTask {
+	task, err := domain.NewFollowUpTask("talent-1", assignee, "follow up", dueAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = gateway.SaveTask(context.Background(), *task)
+	return *task
+}
+
+func TestSchedulerMarksOverdueOnce(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)}
+	gateway := NewInMemoryTaskGateway()
+	waker := &countingWaker{}
+	late := addTask(t, gateway, "ana", clock.now.Add(-time.Hour))
+	addTask(t, gateway, "ana", clock.now.Add(time.Hour))
+
+	scheduler := NewScheduler(gateway, waker, clock, time.Minute, 11)
+	scheduler.Tick(context.Background())
+	scheduler.Tick(context.Background())
+
+	overdue := gateway.ofType(domain.EventTaskOverdue)
+	if len(overdue) != 1 || overdue[0].Task.Id != late.Id {
+		t.Fatalf("expected a single overdue event for the late task, got %+v", overdue)
+	}
+	if !gateway.tasks[late.Id.String()].Overdue {
+		t.Error("expected the late task to be stored as overdue")
+	}
+	if waker.wakes != 1 {
+		t.Errorf("expected the outbox to be woken once, got %d", waker.wakes)
+	}
+	if digests := gateway.ofType(domain.EventTaskDigest); len(digests) != 0 {
+		t.Errorf("expected no digest before the digest hour, got %d", len(digests))
+	}
+}
+
+func TestSchedulerSendsOneDigestPerAssigneePerDay(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2026, 3, 10, 11, 30, 0, 0, time.UTC)}
+	gateway := NewInMemoryTaskGateway()
+	waker := &countingWaker{}
+	addTask(t, gateway, "ana", clock.now.Add(-48*time.Hour))
+	addTask(t, gateway, "ana", clock.now.Add(2*time.Hour))
+	addTask(t, gateway, "bruno", clock.now.Add(time.Hour))
+	addTask(t, gateway, "carla", clock.now.Add(72*time.Hour))
+
+	scheduler := NewScheduler(gateway, waker, clock, time.Minute, 11)
+	scheduler.Tick(context.Background())
+	scheduler.Tick(context.Background())
+
+	digests := gateway.ofType(domain.EventTaskDigest)
+	if len(digests) != 2 {
+		t.Fatalf("expected digests for ana and bruno only, got %d", len(digests))
+	}
+	ana := digests[0].Digest
+	if ana.Assignee != "ana" || ana.Day != "2026-03-10" || len(ana.Overdue) != 1 || len(ana.DueToday) != 1 {
+		t.Errorf("unexpected digest for ana %+v", ana)
+	}
+
+	clock.now = clock.now.Add(24 * time.Hour)
+	scheduler.Tick(context.Background())
+	if digests := gateway.ofType(domain.EventTaskDigest); len(digests) != 4 {
+		t.Errorf("expected new digests for ana and bruno the next day, got %d in total", len(digests))
+	}
+}
+
+func TestSchedulerKeepsTasksCompletedAfterTheScan(t *testing.T) {
+	clock := &fixedClock{now: time.Date(2026, 3, 10, 11, 30, 0, 0, time.UTC)}
+	gateway := NewInMemoryTaskGateway()
+	waker := &countingWaker{}
+	late := addTask(t, gateway, "ana", clock.now.Add(-time.Hour))
+	gateway.beforeMark = func(id string) {
+		task := gateway.tasks[id]
+		task.Complete(clock.now)
+		gateway.tasks[id] = task
+	}
+
+	NewScheduler(gateway, waker, clock, time.Minute, 11).Tick(context.Background())
+
+	stored := gateway.tasks[late.Id.String()]
+	if !stored.Done || stored.Overdue {
+		t.Errorf("expected the task to stay completed and not overdue, got %+v", stored)
+	}
+	if events := gateway.ofType(domain.EventTaskOverdue); len(events) != 0 {
+		t.Errorf("expected no overdue event, got %d", len(events))
+	}
+	if digests := gateway.ofType(domain.EventTaskDigest); len(digests) != 0 {
+		t.Errorf("expected no digest for the completed task, got %d", len(digests))
+	}
+}
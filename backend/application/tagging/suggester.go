@@ -0,0 +1,58 @@
+package tagging
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+// Suggester extracts tags with a TagExtractor built from the whole catalog.
+// The extractor is rebuilt at most once per ttl, so catalog changes take up
+// to ttl to show in the suggestions.
+type Suggester struct {
+	tags  domain.TagGateway
+	clock domain.Clock
+	ttl   time.Duration
+
+	mu        sync.Mutex
+	extractor *domain.TagExtractor
+	loadedAt  time.Time
+}
+
+func NewSuggester(tags domain.TagGateway, clock domain.Clock, ttl time.Duration) *Suggester {
+	return &Suggester{
+		tags:  tags,
+		clock: clock,
+		ttl:   ttl,
+	}
+}
+
+func (s *Suggester) SuggestTags(ctx context.Context, texts ...string) ([]string, error) {
+	extractor, err := s.current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return extractor.Extract(texts...), nil
+}
+
+// current holds the lock while loading, so a cold cache costs a single
+// catalog read.
+func (s *Suggester) current(ctx context.Context) (*domain.TagExtractor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	if s.extractor != nil && now.Sub(s.loadedAt) < s.ttl {
+		return s.extractor, nil
+	}
+
+	tags, err := s.tags.GetAllTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.extractor = domain.NewTagExtractor(tags)
+	s.loadedAt = now
+	return s.extractor, nil
+}
@@ -0,0 +1,58 @@
+package tagging
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type stubTagGateway struct {
+	domain.TagGateway
+	tags  []domain.Tag
+	loads int
+}
+
+func (g *stubTagGateway) GetAllTags(ctx context.Context) ([]domain.Tag, error) {
+	g.loads++
+	return g.tags, nil
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestSuggesterCachesTheCatalog(t *testing.T) {
+	ctx := context.Background()
+	golang, _ := domain.NewTag("Go", domain.TagCategoryLanguage, []string{"golang"})
+	gateway := &stubTagGateway{tags: []domain.Tag{*golang}}
+	clock := &fakeClock{now: time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)}
+	suggester := NewSuggester(gateway, clock, time.Minute)
+
+	tags, err := suggester.SuggestTags(ctx, "Golang developer | Rust")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(tags, []string{"Go"}) {
+		t.Errorf("expected [Go], got %v", tags)
+	}
+
+	rust, _ := domain.NewTag("Rust", domain.TagCategoryLanguage, nil)
+	gateway.tags = append(gateway.tags, *rust)
+	tags, _ = suggester.SuggestTags(ctx, "Golang developer | Rust")
+	if gateway.loads != 1 || len(tags) != 1 {
+		t.Errorf("expected the cached catalog within the ttl, got %d loads and %v", gateway.loads, tags)
+	}
+
+	clock.now = clock.now.Add(time.Minute)
+	tags, _ = suggester.SuggestTags(ctx, "Golang developer | Rust")
+	if gateway.loads != 2 || !slices.Equal(tags, []string{"Go", "Rust"}) {
+		t.Errorf("expected the catalog to be reloaded after the ttl, got %d loads and %v", gateway.loads, tags)
+	}
+}
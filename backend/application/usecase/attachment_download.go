@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type DownloadAttachmentUseCase struct {
+	AttachmentGateway domain.AttachmentGateway
+	BlobStore         domain.BlobStore
+	Ctx               context.Context
+}
+
+func NewDownloadAttachmentUseCase(ctx context.Context, attachmentGateway domain.AttachmentGateway, blobStore domain.BlobStore) *DownloadAttachmentUseCase {
+	return &DownloadAttachmentUseCase{
+		Ctx:               ctx,
+		AttachmentGateway: attachmentGateway,
+		BlobStore:         blobStore,
+	}
+}
+
+type DownloadAttachmentInputDTO struct {
+	TalentId string
+	Id       string
+}
+
+// DownloadAttachmentOutputDTO holds the open content, which the caller must
+// close.
+type DownloadAttachmentOutputDTO struct {
+	Attachment AttachmentOutputDTO
+	Content    io.ReadCloser
+}
+
+func (uc *DownloadAttachmentUseCase) Execute(input DownloadAttachmentInputDTO) (*DownloadAttachmentOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "DownloadAttachmentUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *DownloadAttachmentUseCase) execute(ctx context.Context, input DownloadAttachmentInputDTO) (*DownloadAttachmentOutputDTO, error) {
+	attachment, err := uc.AttachmentGateway.GetAttachment(ctx, input.TalentId, input.Id)
+	if err != nil {
+		return nil, err
+	}
+	content, err := uc.BlobStore.Get(ctx, attachment.BlobKey())
+	if errors.Is(err, domain.ErrBlobNotFound) {
+		// metadata without content means the blob was removed by hand
+		return nil, fmt.Errorf("attachment %s has no content: %w", attachment.Id.String(), err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &DownloadAttachmentOutputDTO{
+		Attachment: newAttachmentOutput(*attachment),
+		Content:    content,
+	}, nil
+}
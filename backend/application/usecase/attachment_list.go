@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type ListAttachmentsUseCase struct {
+	TalentGateway     domain.TalentGateway
+	AttachmentGateway domain.AttachmentGateway
+	Ctx               context.Context
+}
+
+func NewListAttachmentsUseCase(ctx context.Context, talentGateway domain.TalentGateway, attachmentGateway domain.AttachmentGateway) *ListAttachmentsUseCase {
+	return &ListAttachmentsUseCase{
+		Ctx:               ctx,
+		TalentGateway:     talentGateway,
+		AttachmentGateway: attachmentGateway,
+	}
+}
+
+type ListAttachmentsInputDTO struct {
+	TalentId string
+}
+
+type ListAttachmentsOutputDTO struct {
+	Attachments []AttachmentOutputDTO `json:"attachments"`
+}
+
+func (uc *ListAttachmentsUseCase) Execute(input ListAttachmentsInputDTO) (*ListAttachmentsOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "ListAttachmentsUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *ListAttachmentsUseCase) execute(ctx context.Context, input ListAttachmentsInputDTO) (*ListAttachmentsOutputDTO, error) {
+	_, err := uc.TalentGateway.GetTalentById(ctx, input.TalentId)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := uc.AttachmentGateway.GetAttachments(ctx, input.TalentId)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListAttachmentsOutputDTO{Attachments: make([]AttachmentOutputDTO, 0, len(attachments))}
+	for _, attachment := range attachments {
+		output.Attachments = append(output.Attachments, newAttachmentOutput(attachment))
+	}
+	return output, nil
+}
@@ -0,0 +1,242 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type InMemoryAttachmentGateway struct {
+	attachments map[string]domain.Attachment
+}
+
+func NewInMemoryAttachmentGateway() *InMemoryAttachmentGateway {
+	return &InMemoryAttachmentGateway{
+		attachments: make(map[string]domain.Attachment),
+	}
+}
+
+func (g *InMemoryAttachmentGateway) SaveAttachment(ctx context.Context, attachment domain.Attachment) error {
+	g.attachments[attachment.Id.String()] = attachment
+	return nil
+}
+func (g *InMemoryAttachmentGateway) GetAttachment(ctx context.Context, talentId string, id string) (*domain.Attachment, error) {
+	if attachment, exists := g.attachments[id]; exists && attachment.TalentId == talentId {
+		return &attachment, nil
+	}
+	return nil, domain.ErrAttachmentNotFound
+}
+func (g *InMemoryAttachmentGateway) GetAttachments(ctx context.Context, talentId string) ([]domain.Attachment, error) {
+	var attachments []domain.Attachment
+	for _, attachment := range g.attachments {
+		if attachment.TalentId == talentId {
+			attachments = append(attachments, attachment)
+		}
+	}
+	return attachments, nil
+}
+
+func (g *InMemoryAttachmentGateway) GetPendingAttachments(ctx context.Context, limit int) ([]domain.Attachment, error) {
+	var attachments []domain.Attachment
+	for _, attachment := range g.attachments {
+		if attachment.TextStatus == domain.TextPending && len(attachments) < limit {
+			attachments = append(attachments, attachment)
+		}
+	}
+	return attachments, nil
+}
+
+type RecordingExtractionQueue struct {
+	attachments []domain.Attachment
+}
+
+func (q *RecordingExtractionQueue) Enqueue(attachment domain.Attachment) {
+	q.attachments = append(q.attachments, attachment)
+}
+
+type InMemoryBlobStore struct {
+	blobs map[string][]byte
+	puts  int
+}
+
+func NewInMemoryBlobStore() *InMemoryBlobStore {
+	return &InMemoryBlobStore{
+		blobs: make(map[string][]byte),
+	}
+}
+
+func (s *InMemoryBlobStore) Put(ctx context.Context, key string, content io.Reader, contentType string) error {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	s.puts++
+	s.blobs[key] = data
+	return nil
+}
+func (s *InMemoryBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, exists := s.blobs[key]
+	if !exists {
+		return nil, domain.ErrBlobNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+func (s *InMemoryBlobStore) Exists(ctx context.Context, key string) (bool, error) {
+	_, exists := s.blobs[key]
+	return exists, nil
+}
+
+func createAttachmentTalent(t *testing.T, talents domain.TalentGateway) string {
+	t.Helper()
+	output, err := NewCreateTalentUseCase(context.Background(), talents, NewInMemoryTagGateway(), noSuggestions{}).Execute(CreateTalentInputDTO{
+		ProfileURL:   "https://linkedin.com/in/attachment",
+		PossibleRole: "Backend Engineer",
+		FullName:     "Ana Souza",
+		Headline:     "Backend Engineer",
+		CurrentRole:  "Backend Engineer",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return output.Id
+}
+
+func TestUploadAttachmentStoresContentOnce(t *testing.T) {
+	ctx := context.Background()
+	talents := NewInMemoryTalentGateway()
+	attachments := NewInMemoryAttachmentGateway()
+	blobs := NewInMemoryBlobStore()
+	talentId := createAttachmentTalent(t, talents)
+
+	pdf := []byte("%PDF-1.7\n%âãÏÓ\n")
+	var outputs []*AttachmentOutputDTO
+	for _, name := range []string{"cv.pdf", "cv-copy.pdf"} {
+		output, err := NewUploadAttachmentUseCase(ctx, talents, attachments, blobs, &RecordingExtractionQueue{}, 1024).Execute(UploadAttachmentInputDTO{
+			TalentId: talentId,
+			Kind:     "CV",
+			FileName: name,
+			Content:  bytes.NewReader(pdf),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		outputs = append(outputs, output)
+	}
+
+	if outputs[0].Id == outputs[1].Id || outputs[0].SHA256 != outputs[1].SHA256 {
+		t.Errorf("expected two attachments with the same checksum, got %+v", outputs)
+	}
+	if outputs[0].ContentType != domain.ContentTypePDF || outputs[0].Kind != domain.AttachmentKindCV {
+		t.Errorf("unexpected attachment %+v", outputs[0])
+	}
+	if blobs.puts != 1 {
+		t.Errorf("expected the content to be stored once, got %d writes", blobs.puts)
+	}
+
+	download, err := NewDownloadAttachmentUseCase(ctx, attachments, blobs).Execute(DownloadAttachmentInputDTO{
+		TalentId: talentId,
+		Id:       outputs[1].Id,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer download.Content.Close()
+	content, _ := io.ReadAll(download.Content)
+	if !bytes.Equal(content, pdf) || download.Attachment.FileName != "cv-copy.pdf" {
+		t.Errorf("unexpected download %+v", download.Attachment)
+	}
+}
+
+func TestUploadAttachmentRejections(t *testing.T) {
+	ctx := context.Background()
+	talents := NewInMemoryTalentGateway()
+	talentId := createAttachmentTalent(t, talents)
+
+	tests := []struct {
+		name     string
+		talentId string
+		kind     string
+		content  []byte
+		want     error
+	}{
+		{"too large", talentId, "", bytes.Repeat([]byte("a"), 17), domain.ErrAttachmentTooLarge},
+		{"executable", talentId, "", []byte("MZ\x90\x00\x03\x00"), domain.ErrUnsupportedAttachment},
+		{"empty", talentId, "", nil, domain.ErrInvalidAttachment},
+		{"unknown kind", talentId, "photo", []byte("hello"), domain.ErrInvalidAttachment},
+		{"unknown talent", "missing", "", []byte("hello"), domain.ErrTalentNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blobs := NewInMemoryBlobStore()
+			_, err := NewUploadAttachmentUseCase(ctx, talents, NewInMemoryAttachmentGateway(), blobs, &RecordingExtractionQueue{}, 16).Execute(UploadAttachmentInputDTO{
+				TalentId: tt.talentId,
+				Kind:     tt.kind,
+				FileName: "file",
+				Content:  bytes.NewReader(tt.content),
+			})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+			if len(blobs.blobs) != 0 {
+				t.Error("expected nothing stored")
+			}
+		})
+	}
+}
+
+func TestUploadAttachmentDetectsWordDocuments(t *testing.T) {
+	var docx bytes.Buffer
+	archive := zip.NewWriter(&docx)
+	part, _ := archive.Create("word/document.xml")
+	_, _ = part.Write([]byte("<w:document/>"))
+	_ = archive.Close()
+
+	ctx := context.Background()
+	talents := NewInMemoryTalentGateway()
+	talentId := createAttachmentTalent(t, talents)
+	upload := func(name string) (*AttachmentOutputDTO, error) {
+		return NewUploadAttachmentUseCase(ctx, talents, NewInMemoryAttachmentGateway(), NewInMemoryBlobStore(), &RecordingExtractionQueue{}, 1<<20).Execute(UploadAttachmentInputDTO{
+			TalentId: talentId,
+			FileName: name,
+			Content:  bytes.NewReader(docx.Bytes()),
+		})
+	}
+
+	output, err := upload("cv.docx")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.ContentType != domain.ContentTypeDOCX {
+		t.Errorf("expected a Word document, got %s", output.ContentType)
+	}
+	if _, err = upload("cv.zip"); !errors.Is(err, domain.ErrUnsupportedAttachment) {
+		t.Errorf("expected a plain zip to be rejected, got %v", err)
+	}
+}
+
+func TestUploadAttachmentQueuesTextExtraction(t *testing.T) {
+	ctx := context.Background()
+	talents := NewInMemoryTalentGateway()
+	talentId := createAttachmentTalent(t, talents)
+	queue := &RecordingExtractionQueue{}
+
+	for _, content := range [][]byte{[]byte("Go, Kubernetes"), []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")} {
+		_, err := NewUploadAttachmentUseCase(ctx, talents, NewInMemoryAttachmentGateway(), NewInMemoryBlobStore(), queue, 1024).Execute(UploadAttachmentInputDTO{
+			TalentId: talentId,
+			FileName: "file",
+			Content:  bytes.NewReader(content),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(queue.attachments) != 1 || queue.attachments[0].ContentType != domain.ContentTypeText {
+		t.Errorf("expected only the text file to be queued, got %+v", queue.attachments)
+	}
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type GetAttachmentTextUseCase struct {
+	AttachmentGateway domain.AttachmentGateway
+	BlobStore         domain.BlobStore
+	Ctx               context.Context
+}
+
+func NewGetAttachmentTextUseCase(ctx context.Context, attachmentGateway domain.AttachmentGateway, blobStore domain.BlobStore) *GetAttachmentTextUseCase {
+	return &GetAttachmentTextUseCase{
+		Ctx:               ctx,
+		AttachmentGateway: attachmentGateway,
+		BlobStore:         blobStore,
+	}
+}
+
+type GetAttachmentTextInputDTO struct {
+	TalentId string
+	Id       string
+}
+
+// GetAttachmentTextOutputDTO holds the open text, which the caller must
+// close.
+type GetAttachmentTextOutputDTO struct {
+	Text io.ReadCloser
+}
+
+func (uc *GetAttachmentTextUseCase) Execute(input GetAttachmentTextInputDTO) (*GetAttachmentTextOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "GetAttachmentTextUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *GetAttachmentTextUseCase) execute(ctx context.Context, input GetAttachmentTextInputDTO) (*GetAttachmentTextOutputDTO, error) {
+	attachment, err := uc.AttachmentGateway.GetAttachment(ctx, input.TalentId, input.Id)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.TextStatus != domain.TextDone {
+		status := attachment.TextStatus
+		if status == "" {
+			status = domain.TextSkipped
+		}
+		return nil, fmt.Errorf("%w: extraction is %s", domain.ErrTextNotReady, status)
+	}
+
+	text, err := uc.BlobStore.Get(ctx, attachment.TextBlobKey())
+	if err != nil {
+		return nil, err
+	}
+	return &GetAttachmentTextOutputDTO{Text: text}, nil
+}
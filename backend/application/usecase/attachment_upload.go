@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type UploadAttachmentUseCase struct {
+	TalentGateway     domain.TalentGateway
+	AttachmentGateway domain.AttachmentGateway
+	BlobStore         domain.BlobStore
+	Queue             domain.ExtractionQueue
+	MaxBytes          int64
+	Ctx               context.Context
+}
+
+func NewUploadAttachmentUseCase(ctx context.Context, talentGateway domain.TalentGateway, attachmentGateway domain.AttachmentGateway,
+	blobStore domain.BlobStore, queue domain.ExtractionQueue, maxBytes int64) *UploadAttachmentUseCase {
+	return &UploadAttachmentUseCase{
+		Ctx:               ctx,
+		TalentGateway:     talentGateway,
+		AttachmentGateway: attachmentGateway,
+		BlobStore:         blobStore,
+		Queue:             queue,
+		MaxBytes:          maxBytes,
+	}
+}
+
+type UploadAttachmentInputDTO struct {
+	TalentId string
+	Kind     string
+	FileName string
+	Content  io.Reader
+}
+
+type AttachmentOutputDTO struct {
+	Id          string            `json:"id"`
+	TalentId    string            `json:"talent_id"`
+	Kind        string            `json:"kind"`
+	FileName    string            `json:"file_name"`
+	ContentType string            `json:"content_type"`
+	Size        int64             `json:"size"`
+	SHA256      string            `json:"sha256"`
+	UploadedAt  time.Time         `json:"uploaded_at"`
+	Text        AttachmentTextDTO `json:"text"`
+}
+
+// AttachmentTextDTO follows the extraction, which finishes after the upload
+// returns.
+type AttachmentTextDTO struct {
+	Status        string     `json:"status"`
+	Error         string     `json:"error,omitempty"`
+	Length        int        `json:"length,omitempty"`
+	ExtractedAt   *time.Time `json:"extracted_at,omitempty"`
+	SuggestedTags []string   `json:"suggested_tags"`
+}
+
+func newAttachmentOutput(attachment domain.Attachment) AttachmentOutputDTO {
+	output := AttachmentOutputDTO{
+		Id:          attachment.Id.String(),
+		TalentId:    attachment.TalentId,
+		Kind:        attachment.Kind,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		SHA256:      attachment.SHA256,
+		UploadedAt:  attachment.UploadedAt,
+		Text: AttachmentTextDTO{
+			Status:        attachment.TextStatus,
+			Error:         attachment.TextError,
+			Length:        attachment.TextLength,
+			SuggestedTags: attachment.SuggestedTags,
+		},
+	}
+	if output.Text.Status == "" {
+		// uploaded before text extraction existed
+		output.Text.Status = domain.TextSkipped
+	}
+	if !attachment.ExtractedAt.IsZero() {
+		output.Text.ExtractedAt = &attachment.ExtractedAt
+	}
+	if output.Text.SuggestedTags == nil {
+		output.Text.SuggestedTags = []string{}
+	}
+	return output
+}
+
+func (uc *UploadAttachmentUseCase) Execute(input UploadAttachmentInputDTO) (*AttachmentOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "UploadAttachmentUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *UploadAttachmentUseCase) execute(ctx context.Context, input UploadAttachmentInputDTO) (*AttachmentOutputDTO, error) {
+	_, err := uc.TalentGateway.GetTalentById(ctx, input.TalentId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the whole file is read before anything is stored, to know its size,
+	// type and checksum
+	content, err := io.ReadAll(io.LimitReader(input.Content, uc.MaxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > uc.MaxBytes {
+		return nil, fmt.Errorf("%w: the limit is %d bytes", domain.ErrAttachmentTooLarge, uc.MaxBytes)
+	}
+	sum := sha256.Sum256(content)
+
+	attachment, err := domain.NewAttachment(input.TalentId, input.Kind, input.FileName, sniffContentType(content, input.FileName),
+		int64(len(content)), hex.EncodeToString(sum[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.BlobStore.Exists(ctx, attachment.BlobKey())
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		err = uc.BlobStore.Put(ctx, attachment.BlobKey(), bytes.NewReader(content), attachment.ContentType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = uc.AttachmentGateway.SaveAttachment(ctx, *attachment)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.TextStatus == domain.TextPending {
+		uc.Queue.Enqueue(*attachment)
+	}
+
+	logging.FromContext(ctx).Info("attachment uploaded", "attachment_id", attachment.Id.String(), "talent_id", attachment.TalentId,
+		"content_type", attachment.ContentType, "size", attachment.Size, "deduplicated", exists)
+	output := newAttachmentOutput(*attachment)
+	return &output, nil
+}
+
+// sniffContentType looks at the content only. DOCX files are zip archives,
+// told apart by the document part every Word file has.
+func sniffContentType(content []byte, fileName string) string {
+	detected := http.DetectContentType(content)
+	if detected == "application/zip" && strings.EqualFold(path.Ext(fileName), ".docx") && isWordDocument(content) {
+		return domain.ContentTypeDOCX
+	}
+	return detected
+}
+
+func isWordDocument(content []byte) bool {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return false
+	}
+	for _, file := range archive.File {
+		if file.Name == "word/document.xml" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type CreateSavedSearchUseCase struct {
+	SavedSearchGateway domain.SavedSearchGateway
+	Ctx                context.Context
+}
+
+func NewCreateSavedSearchUseCase(ctx context.Context, savedSearchGateway domain.SavedSearchGateway) *CreateSavedSearchUseCase {
+	return &CreateSavedSearchUseCase{
+		Ctx:                ctx,
+		SavedSearchGateway: savedSearchGateway,
+	}
+}
+
+// TalentFilterDTO mirrors the query parameters of GET /talents.
+type TalentFilterDTO struct {
+	Query           string                `json:"q,omitempty"`
+	Name            string                `json:"name,omitempty"`
+	PossibleRole    string                `json:"possible_role,omitempty"`
+	Tags            []string              `json:"tags,omitempty"`
+	Stage           string                `json:"stage,omitempty"`
+	Function        string                `json:"function,omitempty"`
+	Seniority       string                `json:"seniority,omitempty"`
+	Location        LocationFilterDTO     `json:"location"`
+	Compensation    CompensationFilterDTO `json:"compensation"`
+	IncludeArchived bool                  `json:"include_archived,omitempty"`
+}
+
+type LocationFilterDTO struct {
+	Countries        []string `json:"countries,omitempty"`
+	State            string   `json:"state,omitempty"`
+	City             string   `json:"city,omitempty"`
+	Timezone         string   `json:"timezone,omitempty"`
+	WorkModel        string   `json:"work_model,omitempty"`
+	OpenToRelocation bool     `json:"open_to_relocation,omitempty"`
+}
+
+// CompensationFilterDTO amounts are in cents of Currency per Period.
+type CompensationFilterDTO struct {
+	Currency string `json:"currency,omitempty"`
+	Period   string `json:"period,omitempty"`
+	Min      int64  `json:"min,omitempty"`
+	Max      int64  `json:"max,omitempty"`
+	Contract string `json:"contract,omitempty"`
+}
+
+func (f TalentFilterDTO) toDomain() domain.TalentFilter {
+	return domain.TalentFilter{
+		Query:        f.Query,
+		Name:         f.Name,
+		PossibleRole: f.PossibleRole,
+		Tags:         f.Tags,
+		Stage:        f.Stage,
+		Function:     f.Function,
+		Seniority:    f.Seniority,
+		Location: domain.LocationFilter{
+			Countries:        f.Location.Countries,
+			State:            f.Location.State,
+			City:             f.Location.City,
+			Timezone:         f.Location.Timezone,
+			WorkModel:        f.Location.WorkModel,
+			OpenToRelocation: f.Location.OpenToRelocation,
+		},
+		Compensation: domain.CompensationFilter{
+			Currency: f.Compensation.Currency,
+			Period:   f.Compensation.Period,
+			Min:      f.Compensation.Min,
+			Max:      f.Compensation.Max,
+			Contract: f.Compensation.Contract,
+		},
+		IncludeArchived: f.IncludeArchived,
+	}
+}
+
+func newTalentFilterDTO(filter domain.TalentFilter) TalentFilterDTO {
+	return TalentFilterDTO{
+		Query:        filter.Query,
+		Name:         filter.Name,
+		PossibleRole: filter.PossibleRole,
+		Tags:         filter.Tags,
+		Stage:        filter.Stage,
+		Function:     filter.Function,
+		Seniority:    filter.Seniority,
+		Location: LocationFilterDTO{
+			Countries:        filter.Location.Countries,
+			State:            filter.Location.State,
+			City:             filter.Location.City,
+			Timezone:         filter.Location.Timezone,
+			WorkModel:        filter.Location.WorkModel,
+			OpenToRelocation: filter.Location.OpenToRelocation,
+		},
+		Compensation: CompensationFilterDTO{
+			Currency: filter.Compensation.Currency,
+			Period:   filter.Compensation.Period,
+			Min:      filter.Compensation.Min,
+			Max:      filter.Compensation.Max,
+			Contract: filter.Compensation.Contract,
+		},
+		IncludeArchived: filter.IncludeArchived,
+	}
+}
+
+type CreateSavedSearchInputDTO struct {
+	Name   string          `json:"name"`
+	Owner  string          `json:"owner"`
+	Shared bool            `json:"shared"`
+	Filter TalentFilterDTO `json:"filter"`
+	// CompensationAccess is needed to save compensation filters.
+	CompensationAccess bool `json:"-"`
+}
+
+type SavedSearchOutputDTO struct {
+	Id        string          `json:"id"`
+	Name      string          `json:"name"`
+	Owner     string          `json:"owner"`
+	Shared    bool            `json:"shared"`
+	Filter    TalentFilterDTO `json:"filter"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func newSavedSearchOutput(search domain.SavedSearch) SavedSearchOutputDTO {
+	return SavedSearchOutputDTO{
+		Id:        search.Id.String(),
+		Name:      search.Name,
+		Owner:     search.Owner,
+		Shared:    search.Shared,
+		Filter:    newTalentFilterDTO(search.Filter),
+		CreatedAt: search.CreatedAt,
+	}
+}
+
+func (uc *CreateSavedSearchUseCase) Execute(input CreateSavedSearchInputDTO) (*SavedSearchOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "CreateSavedSearchUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *CreateSavedSearchUseCase) execute(ctx context.Context, input CreateSavedSearchInputDTO) (*SavedSearchOutputDTO, error) {
+	filter := input.Filter.toDomain()
+	if filter.UsesCompensation() && !input.CompensationAccess {
+		return nil, domain.ErrCompensationForbidden
+	}
+
+	search, err := domain.NewSavedSearch(input.Name, input.Owner, input.Shared, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.SavedSearchGateway.SaveSavedSearch(ctx, *search)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Info("saved search created", "saved_search_id", search.Id.String(), "owner", search.Owner, "shared", search.Shared)
+	output := newSavedSearchOutput(*search)
+	return &output, nil
+}
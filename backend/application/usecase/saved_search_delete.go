@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type DeleteSavedSearchUseCase struct {
+	SavedSearchGateway domain.SavedSearchGateway
+	Ctx                context.Context
+}
+
+func NewDeleteSavedSearchUseCase(ctx context.Context, savedSearchGateway domain.SavedSearchGateway) *DeleteSavedSearchUseCase {
+	return &DeleteSavedSearchUseCase{
+		Ctx:                ctx,
+		SavedSearchGateway: savedSearchGateway,
+	}
+}
+
+type DeleteSavedSearchInputDTO struct {
+	Id    string
+	Owner string
+}
+
+func (uc *DeleteSavedSearchUseCase) Execute(input DeleteSavedSearchInputDTO) error {
+	ctx, span := tracer.Start(uc.Ctx, "DeleteSavedSearchUseCase.Execute")
+	err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return err
+}
+
+func (uc *DeleteSavedSearchUseCase) execute(ctx context.Context, input DeleteSavedSearchInputDTO) error {
+	search, err := uc.SavedSearchGateway.GetSavedSearchById(ctx, input.Id)
+	if err != nil {
+		return err
+	}
+	if !search.VisibleTo(input.Owner) {
+		return domain.ErrSavedSearchNotFound
+	}
+
+	err = uc.SavedSearchGateway.DeleteSavedSearch(ctx, input.Id)
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("saved search deleted", "saved_search_id", input.Id)
+	return nil
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type ListSavedSearchesUseCase struct {
+	SavedSearchGateway domain.SavedSearchGateway
+	Ctx                context.Context
+}
+
+func NewListSavedSearchesUseCase(ctx context.Context, savedSearchGateway domain.SavedSearchGateway) *ListSavedSearchesUseCase {
+	return &ListSavedSearchesUseCase{
+		Ctx:                ctx,
+		SavedSearchGateway: savedSearchGateway,
+	}
+}
+
+// ListSavedSearchesInputDTO lists the searches of Owner and the shared ones.
+type ListSavedSearchesInputDTO struct {
+	Owner string
+}
+
+type ListSavedSearchesOutputDTO struct {
+	Searches []SavedSearchOutputDTO `json:"searches"`
+}
+
+func (uc *ListSavedSearchesUseCase) Execute(input ListSavedSearchesInputDTO) (*ListSavedSearchesOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "ListSavedSearchesUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *ListSavedSearchesUseCase) execute(ctx context.Context, input ListSavedSearchesInputDTO) (*ListSavedSearchesOutputDTO, error) {
+	searches, err := uc.SavedSearchGateway.GetSavedSearches(ctx, input.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListSavedSearchesOutputDTO{Searches: make([]SavedSearchOutputDTO, 0, len(searches))}
+	for _, search := range searches {
+		output.Searches = append(output.Searches, newSavedSearchOutput(search))
+	}
+	return output, nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type RunSavedSearchUseCase struct {
+	SavedSearchGateway domain.SavedSearchGateway
+	TalentGateway      domain.TalentGateway
+	Ctx                context.Context
+}
+
+func NewRunSavedSearchUseCase(ctx context.Context, savedSearchGateway domain.SavedSearchGateway, talentGateway domain.TalentGateway) *RunSavedSearchUseCase {
+	return &RunSavedSearchUseCase{
+		Ctx:                ctx,
+		SavedSearchGateway: savedSearchGateway,
+		TalentGateway:      talentGateway,
+	}
+}
+
+type RunSavedSearchInputDTO struct {
+	Id                 string
+	Owner              string
+	Limit              int
+	Cursor             string
+	Sort               string
+	CompensationAccess bool
+}
+
+func (uc *RunSavedSearchUseCase) Execute(input RunSavedSearchInputDTO) (*ListTalentsOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "RunSavedSearchUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *RunSavedSearchUseCase) execute(ctx context.Context, input RunSavedSearchInputDTO) (*ListTalentsOutputDTO, error) {
+	search, err := uc.SavedSearchGateway.GetSavedSearchById(ctx, input.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !search.VisibleTo(input.Owner) {
+		return nil, domain.ErrSavedSearchNotFound
+	}
+
+	return NewListTalentUseCase(ctx, uc.TalentGateway).execute(ctx, ListTalentsInputDTO{
+		Limit:              input.Limit,
+		Cursor:             input.Cursor,
+		Sort:               input.Sort,
+		Filter:             search.Filter,
+		CompensationAccess: input.CompensationAccess,
+	})
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+// scorecardRetries bounds the attempts to store the summary on a talent
+// changed concurrently.
+const scorecardRetries = 3
+
+type CreateScorecardUseCase struct {
+	TalentGateway    domain.TalentGateway
+	ScorecardGateway domain.ScorecardGateway
+	Ctx              context.Context
+}
+
+func NewCreateScorecardUseCase(ctx context.Context, talentGateway domain.TalentGateway, scorecardGateway domain.ScorecardGateway) *CreateScorecardUseCase {
+	return &CreateScorecardUseCase{
+		Ctx:              ctx,
+		TalentGateway:    talentGateway,
+		ScorecardGateway: scorecardGateway,
+	}
+}
+
+type CriterionScoreDTO struct {
+	Name  string `json:"name"`
+	Score int    `json:"score"`
+}
+
+type CreateScorecardInputDTO struct {
+	TalentId       string              `json:"-"`
+	OpeningId      string              `json:"opening_id"`
+	Evaluator      string              `json:"evaluator"`
+	Criteria       []CriterionScoreDTO `json:"criteria"`
+	Recommendation string              `json:"recommendation"`
+	Comment        string              `json:"comment"`
+}
+
+type ScorecardOutputDTO struct {
+	Id             string              `json:"id"`
+	TalentId       string              `json:"talent_id"`
+	OpeningId      string              `json:"opening_id,omitempty"`
+	Evaluator      string              `json:"evaluator"`
+	Criteria       []CriterionScoreDTO `json:"criteria"`
+	Average        float64             `json:"average"`
+	Recommendation string              `json:"recommendation"`
+	Comment        string              `json:"comment,omitempty"`
+	CreatedAt      time.Time           `json:"created_at"`
+}
+
+func newScorecardOutput(scorecard domain.Scorecard) ScorecardOutputDTO {
+	output := ScorecardOutputDTO{
+		Id:             scorecard.Id.String(),
+		TalentId:       scorecard.TalentId,
+		OpeningId:      scorecard.OpeningId,
+		Evaluator:      scorecard.Evaluator,
+		Criteria:       make([]CriterionScoreDTO, 0, len(scorecard.Criteria)),
+		Recommendation: scorecard.Recommendation,
+		Comment:        scorecard.Comment,
+		CreatedAt:      scorecard.CreatedAt,
+	}
+	for _, criterion := range scorecard.Criteria {
+		output.Criteria = append(output.Criteria, CriterionScoreDTO{Name: criterion.Name, Score: criterion.Score})
+	}
+	if len(scorecard.Criteria) > 0 {
+		output.Average = scorecard.Average()
+	}
+	return output
+}
+
+// ScorecardsDTO aggregates the scorecards of a talent.
+type ScorecardsDTO struct {
+	Count           int                   `json:"count"`
+	Average         float64               `json:"average"`
+	Criteria        []CriterionSummaryDTO `json:"criteria"`
+	Recommendations map[string]int        `json:"recommendations"`
+}
+
+type CriterionSummaryDTO struct {
+	Name    string  `json:"name"`
+	Average float64 `json:"average"`
+	Count   int     `json:"count"`
+}
+
+func newScorecardsDTO(summary domain.ScorecardSummary) *ScorecardsDTO {
+	if summary.Count == 0 {
+		return nil
+	}
+	output := &ScorecardsDTO{
+		Count:           summary.Count,
+		Average:         summary.Average,
+		Criteria:        make([]CriterionSummaryDTO, 0, len(summary.Criteria)),
+		Recommendations: summary.Recommendations,
+	}
+	for _, criterion := range summary.Criteria {
+		output.Criteria = append(output.Criteria, CriterionSummaryDTO{Name: criterion.Name, Average: criterion.Average, Count: criterion.Count})
+	}
+	return output
+}
+
+func (uc *CreateScorecardUseCase) Execute(input CreateScorecardInputDTO) (*ScorecardOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "CreateScorecardUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *CreateScorecardUseCase) execute(ctx context.Context, input CreateScorecardInputDTO) (*ScorecardOutputDTO, error) {
+	_, err := uc.TalentGateway.GetTalentById(ctx, input.TalentId)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria := make([]domain.CriterionScore, 0, len(input.Criteria))
+	for _, criterion := range input.Criteria {
+		criteria = append(criteria, domain.CriterionScore{Name: criterion.Name, Score: criterion.Score})
+	}
+	scorecard, err := domain.NewScorecard(input.TalentId, input.OpeningId, input.Evaluator, criteria, input.Recommendation, input.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.ScorecardGateway.SaveScorecard(ctx, *scorecard)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := logging.FromContext(ctx)
+	logger.Info("scorecard created", "scorecard_id", scorecard.Id.String(), "talent_id", scorecard.TalentId, "evaluator", scorecard.Evaluator)
+	// the scorecard is stored, a stale summary is fixed by the next one
+	err = uc.refreshSummary(ctx, input.TalentId)
+	if err != nil {
+		logger.Error("could not update the scorecard summary", "talent_id", input.TalentId, "error", err)
+	}
+
+	output := newScorecardOutput(*scorecard)
+	return &output, nil
+}
+
+// refreshSummary recomputes the summary from every scorecard of the talent.
+func (uc *CreateScorecardUseCase) refreshSummary(ctx context.Context, talentId string) error {
+	var err error
+	for range scorecardRetries {
+		var scorecards []domain.Scorecard
+		scorecards, err = uc.ScorecardGateway.GetScorecards(ctx, talentId, "")
+		if err != nil {
+			return err
+		}
+		var talent *domain.Talent
+		talent, err = uc.TalentGateway.GetTalentById(ctx, talentId)
+		if err != nil {
+			return err
+		}
+		if !talent.SetScorecards(domain.SummarizeScorecards(scorecards)) {
+			return nil
+		}
+		err = uc.TalentGateway.Save(ctx, talent)
+		if !errors.Is(err, domain.ErrVersionConflict) {
+			return err
+		}
+	}
+	return err
+}
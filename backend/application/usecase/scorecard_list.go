@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type ListScorecardsUseCase struct {
+	TalentGateway    domain.TalentGateway
+	ScorecardGateway domain.ScorecardGateway
+	Ctx              context.Context
+}
+
+func NewListScorecardsUseCase(ctx context.Context, talentGateway domain.TalentGateway, scorecardGateway domain.ScorecardGateway) *ListScorecardsUseCase {
+	return &ListScorecardsUseCase{
+		Ctx:              ctx,
+		TalentGateway:    talentGateway,
+		ScorecardGateway: scorecardGateway,
+	}
+}
+
+type ListScorecardsInputDTO struct {
+	TalentId  string
+	OpeningId string
+}
+
+// ListScorecardsOutputDTO summarizes the listed scorecards, so it only
+// covers the opening when one is given.
+type ListScorecardsOutputDTO struct {
+	Scorecards []ScorecardOutputDTO `json:"scorecards"`
+	Summary    *ScorecardsDTO       `json:"summary,omitempty"`
+}
+
+func (uc *ListScorecardsUseCase) Execute(input ListScorecardsInputDTO) (*ListScorecardsOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "ListScorecardsUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *ListScorecardsUseCase) execute(ctx context.Context, input ListScorecardsInputDTO) (*ListScorecardsOutputDTO, error) {
+	_, err := uc.TalentGateway.GetTalentById(ctx, input.TalentId)
+	if err != nil {
+		return nil, err
+	}
+
+	scorecards, err := uc.ScorecardGateway.GetScorecards(ctx, input.TalentId, input.OpeningId)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListScorecardsOutputDTO{
+		Scorecards: make([]ScorecardOutputDTO, 0, len(scorecards)),
+		Summary:    newScorecardsDTO(domain.SummarizeScorecards(scorecards)),
+	}
+	for _, scorecard := range scorecards {
+		output.Scorecards = append(output.Scorecards, newScorecardOutput(scorecard))
+	}
+	return output, nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type InMemoryScorecardGateway struct {
+	scorecards []domain.Scorecard
+}
+
+func (g *InMemoryScorecardGateway) SaveScorecard(ctx context.Context, scorecard domain.Scorecard) error {
+	g.scorecards = append(g.scorecards, scorecard)
+	return nil
+}
+func (g *InMemoryScorecardGateway) GetScorecards(ctx context.Context, talentId string, openingId string) ([]domain.Scorecard, error) {
+	var scorecards []domain.Scorecard
+	for _, scorecard := range g.scorecards {
+		if scorecard.TalentId == talentId && (openingId == "" || scorecard.OpeningId == openingId) {
+			scorecards = append(scorecards, scorecard)
+		}
+	}
+	return scorecards, nil
+}
+
+func TestListTalentsByRatingPages(t *testing.T) {
+	ctx := context.Background()
+	talents := NewInMemoryTalentGateway()
+	scorecards := &InMemoryScorecardGateway{}
+	ids := make(map[int]string)
+	for _, score := range []int{3, 5, 1, 4} {
+		id := createTestTalent(t, talents)
+		ids[score] = id
+		_, err := NewCreateScorecardUseCase(ctx, talents, scorecards).Execute(CreateScorecardInputDTO{
+			TalentId:       id,
+			Evaluator:      "ana",
+			Criteria:       []CriterionScoreDTO{{Name: "Go", Score: score}},
+			Recommendation: domain.RecommendationYes,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	var got []string
+	cursor := ""
+	for page := 0; page < 3; page++ {
+		output, err := NewListTalentUseCase(ctx, talents).Execute(ListTalentsInputDTO{Limit: 3, Cursor: cursor, Sort: "-rating"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, talent := range output.Talents {
+			got = append(got, talent.Id)
+		}
+		cursor = output.NextCursor
+		if cursor == "" {
+			break
+		}
+	}
+
+	want := []string{ids[5], ids[4], ids[3], ids[1]}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d talents, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("position %d: expected %s, got %s", i, want[i], got[i])
+		}
+	}
+}
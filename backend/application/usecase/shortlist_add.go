@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type AddShortlistTalentUseCase struct {
+	ShortlistGateway domain.ShortlistGateway
+	TalentGateway    domain.TalentGateway
+	Clock            domain.Clock
+	Ctx              context.Context
+}
+
+func NewAddShortlistTalentUseCase(ctx context.Context, shortlistGateway domain.ShortlistGateway, talentGateway domain.TalentGateway, clock domain.Clock) *AddShortlistTalentUseCase {
+	return &AddShortlistTalentUseCase{
+		Ctx:              ctx,
+		ShortlistGateway: shortlistGateway,
+		TalentGateway:    talentGateway,
+		Clock:            clock,
+	}
+}
+
+type AddShortlistTalentInputDTO struct {
+	Id       string `json:"-"`
+	Owner    string `json:"-"`
+	TalentId string `json:"talent_id"`
+	// Position is zero based, the talent goes to the end when it is missing.
+	Position *int `json:"position"`
+}
+
+func (uc *AddShortlistTalentUseCase) Execute(input AddShortlistTalentInputDTO) (*ShortlistOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "AddShortlistTalentUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *AddShortlistTalentUseCase) execute(ctx context.Context, input AddShortlistTalentInputDTO) (*ShortlistOutputDTO, error) {
+	_, err := uc.TalentGateway.GetTalentById(ctx, input.TalentId)
+	if err != nil {
+		return nil, err
+	}
+
+	position := -1
+	if input.Position != nil {
+		position = *input.Position
+	}
+	shortlist, err := changeShortlist(ctx, uc.ShortlistGateway, input.Id, input.Owner, func(shortlist *domain.Shortlist) (bool, error) {
+		return shortlist.Add(input.TalentId, position, uc.Clock.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Info("talent added to shortlist", "shortlist_id", input.Id, "talent_id", input.TalentId)
+	output := newShortlistOutput(*shortlist)
+	return &output, nil
+}
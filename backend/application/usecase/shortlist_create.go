@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+// shortlistRetries bounds the attempts to change a shortlist edited
+// concurrently.
+const shortlistRetries = 3
+
+type CreateShortlistUseCase struct {
+	ShortlistGateway domain.ShortlistGateway
+	Ctx              context.Context
+}
+
+func NewCreateShortlistUseCase(ctx context.Context, shortlistGateway domain.ShortlistGateway) *CreateShortlistUseCase {
+	return &CreateShortlistUseCase{
+		Ctx:              ctx,
+		ShortlistGateway: shortlistGateway,
+	}
+}
+
+type CreateShortlistInputDTO struct {
+	Name   string `json:"name"`
+	Owner  string `json:"owner"`
+	Shared bool   `json:"shared"`
+}
+
+type ShortlistOutputDTO struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	Owner     string    `json:"owner"`
+	Shared    bool      `json:"shared"`
+	TalentIds []string  `json:"talent_ids"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Version   int64     `json:"version"`
+}
+
+func newShortlistOutput(shortlist domain.Shortlist) ShortlistOutputDTO {
+	talentIds := shortlist.TalentIds
+	if talentIds == nil {
+		talentIds = []string{}
+	}
+	return ShortlistOutputDTO{
+		Id:        shortlist.Id.String(),
+		Name:      shortlist.Name,
+		Owner:     shortlist.Owner,
+		Shared:    shortlist.Shared,
+		TalentIds: talentIds,
+		CreatedAt: shortlist.CreatedAt,
+		UpdatedAt: shortlist.UpdatedAt,
+		Version:   shortlist.Version,
+	}
+}
+
+func (uc *CreateShortlistUseCase) Execute(input CreateShortlistInputDTO) (*ShortlistOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "CreateShortlistUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *CreateShortlistUseCase) execute(ctx context.Context, input CreateShortlistInputDTO) (*ShortlistOutputDTO, error) {
+	shortlist, err := domain.NewShortlist(input.Name, input.Owner, input.Shared)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.ShortlistGateway.SaveShortlist(ctx, shortlist)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Info("shortlist created", "shortlist_id", shortlist.Id.String(), "owner", shortlist.Owner, "shared", shortlist.Shared)
+	output := newShortlistOutput(*shortlist)
+	return &output, nil
+}
+
+// getVisibleShortlist hides the private shortlists of other owners as if they
+// did not exist.
+func getVisibleShortlist(ctx context.Context, gateway domain.ShortlistGateway, id string, owner string) (*domain.Shortlist, error) {
+	shortlist, err := gateway.GetShortlistById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !shortlist.VisibleTo(owner) {
+		return nil, domain.ErrShortlistNotFound
+	}
+	return shortlist, nil
+}
+
+// changeShortlist reads the shortlist again and reapplies change when it was
+// saved by someone else in the meantime. change reports whether there is
+// something to save.
+func changeShortlist(ctx context.Context, gateway domain.ShortlistGateway, id string, owner string, change func(*domain.Shortlist) (bool, error)) (*domain.Shortlist, error) {
+	var err error
+	for range shortlistRetries {
+		var shortlist *domain.Shortlist
+		shortlist, err = getVisibleShortlist(ctx, gateway, id, owner)
+		if err != nil {
+			return nil, err
+		}
+		var changed bool
+		changed, err = change(shortlist)
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			return shortlist, nil
+		}
+		err = gateway.SaveShortlist(ctx, shortlist)
+		if err == nil {
+			return shortlist, nil
+		}
+		if !errors.Is(err, domain.ErrShortlistConflict) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type DeleteShortlistUseCase struct {
+	ShortlistGateway domain.ShortlistGateway
+	Ctx              context.Context
+}
+
+func NewDeleteShortlistUseCase(ctx context.Context, shortlistGateway domain.ShortlistGateway) *DeleteShortlistUseCase {
+	return &DeleteShortlistUseCase{
+		Ctx:              ctx,
+		ShortlistGateway: shortlistGateway,
+	}
+}
+
+type DeleteShortlistInputDTO struct {
+	Id    string
+	Owner string
+}
+
+func (uc *DeleteShortlistUseCase) Execute(input DeleteShortlistInputDTO) error {
+	ctx, span := tracer.Start(uc.Ctx, "DeleteShortlistUseCase.Execute")
+	err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return err
+}
+
+func (uc *DeleteShortlistUseCase) execute(ctx context.Context, input DeleteShortlistInputDTO) error {
+	_, err := getVisibleShortlist(ctx, uc.ShortlistGateway, input.Id, input.Owner)
+	if err != nil {
+		return err
+	}
+
+	err = uc.ShortlistGateway.DeleteShortlist(ctx, input.Id)
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("shortlist deleted", "shortlist_id", input.Id)
+	return nil
+}
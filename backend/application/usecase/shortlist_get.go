@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type GetShortlistUseCase struct {
+	ShortlistGateway domain.ShortlistGateway
+	TalentGateway    domain.TalentGateway
+	Ctx              context.Context
+}
+
+func NewGetShortlistUseCase(ctx context.Context, shortlistGateway domain.ShortlistGateway, talentGateway domain.TalentGateway) *GetShortlistUseCase {
+	return &GetShortlistUseCase{
+		Ctx:              ctx,
+		ShortlistGateway: shortlistGateway,
+		TalentGateway:    talentGateway,
+	}
+}
+
+type GetShortlistInputDTO struct {
+	Id                 string
+	Owner              string
+	CompensationAccess bool
+}
+
+// GetShortlistOutputDTO has the talents in the order of the shortlist.
+// Talents deleted after being added are left out of Talents but kept in
+// TalentIds.
+type GetShortlistOutputDTO struct {
+	ShortlistOutputDTO
+	Talents []TalentDTO `json:"talents"`
+}
+
+func (uc *GetShortlistUseCase) Execute(input GetShortlistInputDTO) (*GetShortlistOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "GetShortlistUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *GetShortlistUseCase) execute(ctx context.Context, input GetShortlistInputDTO) (*GetShortlistOutputDTO, error) {
+	shortlist, err := getVisibleShortlist(ctx, uc.ShortlistGateway, input.Id, input.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GetShortlistOutputDTO{
+		ShortlistOutputDTO: newShortlistOutput(*shortlist),
+		Talents:            make([]TalentDTO, 0, len(shortlist.TalentIds)),
+	}
+	for _, id := range shortlist.TalentIds {
+		talent, err := uc.TalentGateway.GetTalentById(ctx, id)
+		if errors.Is(err, domain.ErrTalentNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		output.Talents = append(output.Talents, newTalentDTO(*talent, input.CompensationAccess))
+	}
+	return output, nil
+}
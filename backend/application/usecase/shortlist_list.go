@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type ListShortlistsUseCase struct {
+	ShortlistGateway domain.ShortlistGateway
+	Ctx              context.Context
+}
+
+func NewListShortlistsUseCase(ctx context.Context, shortlistGateway domain.ShortlistGateway) *ListShortlistsUseCase {
+	return &ListShortlistsUseCase{
+		Ctx:              ctx,
+		ShortlistGateway: shortlistGateway,
+	}
+}
+
+// ListShortlistsInputDTO lists the shortlists of Owner and the shared ones.
+type ListShortlistsInputDTO struct {
+	Owner string
+}
+
+type ListShortlistsOutputDTO struct {
+	Shortlists []ShortlistOutputDTO `json:"shortlists"`
+}
+
+func (uc *ListShortlistsUseCase) Execute(input ListShortlistsInputDTO) (*ListShortlistsOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "ListShortlistsUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *ListShortlistsUseCase) execute(ctx context.Context, input ListShortlistsInputDTO) (*ListShortlistsOutputDTO, error) {
+	shortlists, err := uc.ShortlistGateway.GetShortlists(ctx, input.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListShortlistsOutputDTO{Shortlists: make([]ShortlistOutputDTO, 0, len(shortlists))}
+	for _, shortlist := range shortlists {
+		output.Shortlists = append(output.Shortlists, newShortlistOutput(shortlist))
+	}
+	return output, nil
+}
@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type RemoveShortlistTalentUseCase struct {
+	ShortlistGateway domain.ShortlistGateway
+	Clock            domain.Clock
+	Ctx              context.Context
+}
+
+func NewRemoveShortlistTalentUseCase(ctx context.Context, shortlistGateway domain.ShortlistGateway, clock domain.Clock) *RemoveShortlistTalentUseCase {
+	return &RemoveShortlistTalentUseCase{
+		Ctx:              ctx,
+		ShortlistGateway: shortlistGateway,
+		Clock:            clock,
+	}
+}
+
+type RemoveShortlistTalentInputDTO struct {
+	Id       string
+	Owner    string
+	TalentId string
+}
+
+func (uc *RemoveShortlistTalentUseCase) Execute(input RemoveShortlistTalentInputDTO) (*ShortlistOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "RemoveShortlistTalentUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+// execute does not look the talent up, so deleted talents can still be
+// removed from the shortlist.
+func (uc *RemoveShortlistTalentUseCase) execute(ctx context.Context, input RemoveShortlistTalentInputDTO) (*ShortlistOutputDTO, error) {
+	shortlist, err := changeShortlist(ctx, uc.ShortlistGateway, input.Id, input.Owner, func(shortlist *domain.Shortlist) (bool, error) {
+		return shortlist.Remove(input.TalentId, uc.Clock.Now()), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Info("talent removed from shortlist", "shortlist_id", input.Id, "talent_id", input.TalentId)
+	output := newShortlistOutput(*shortlist)
+	return &output, nil
+}
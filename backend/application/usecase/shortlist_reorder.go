@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type ReorderShortlistUseCase struct {
+	ShortlistGateway domain.ShortlistGateway
+	Clock            domain.Clock
+	Ctx              context.Context
+}
+
+func NewReorderShortlistUseCase(ctx context.Context, shortlistGateway domain.ShortlistGateway, clock domain.Clock) *ReorderShortlistUseCase {
+	return &ReorderShortlistUseCase{
+		Ctx:              ctx,
+		ShortlistGateway: shortlistGateway,
+		Clock:            clock,
+	}
+}
+
+type ReorderShortlistInputDTO struct {
+	Id        string   `json:"-"`
+	Owner     string   `json:"-"`
+	TalentIds []string `json:"talent_ids"`
+}
+
+func (uc *ReorderShortlistUseCase) Execute(input ReorderShortlistInputDTO) (*ShortlistOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "ReorderShortlistUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *ReorderShortlistUseCase) execute(ctx context.Context, input ReorderShortlistInputDTO) (*ShortlistOutputDTO, error) {
+	shortlist, err := changeShortlist(ctx, uc.ShortlistGateway, input.Id, input.Owner, func(shortlist *domain.Shortlist) (bool, error) {
+		return true, shortlist.Reorder(input.TalentIds, uc.Clock.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Info("shortlist reordered", "shortlist_id", input.Id)
+	output := newShortlistOutput(*shortlist)
+	return &output, nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type InMemoryShortlistGateway struct {
+	shortlists map[string]domain.Shortlist
+	// conflicts makes the next saves fail as if someone else saved first.
+	conflicts int
+}
+
+func NewInMemoryShortlistGateway() *InMemoryShortlistGateway {
+	return &InMemoryShortlistGateway{
+		shortlists: make(map[string]domain.Shortlist),
+	}
+}
+
+func (g *InMemoryShortlistGateway) SaveShortlist(ctx context.Context, shortlist *domain.Shortlist) error {
+	if g.conflicts > 0 {
+		g.conflicts--
+		return domain.ErrShortlistConflict
+	}
+	if g.shortlists[shortlist.Id.String()].Version != shortlist.Version {
+		return domain.ErrShortlistConflict
+	}
+	shortlist.Version++
+	stored := *shortlist
+	stored.TalentIds = slices.Clone(shortlist.TalentIds)
+	g.shortlists[shortlist.Id.String()] = stored
+	return nil
+}
+func (g *InMemoryShortlistGateway) GetShortlistById(ctx context.Context, id string) (*domain.Shortlist, error) {
+	if shortlist, exists := g.shortlists[id]; exists {
+		shortlist.TalentIds = slices.Clone(shortlist.TalentIds)
+		return &shortlist, nil
+	}
+	return nil, domain.ErrShortlistNotFound
+}
+func (g *InMemoryShortlistGateway) GetShortlists(ctx context.Context, owner string) ([]domain.Shortlist, error) {
+	var shortlists []domain.Shortlist
+	for _, shortlist := range g.shortlists {
+		if shortlist.VisibleTo(owner) {
+			shortlists = append(shortlists, shortlist)
+		}
+	}
+	return shortlists, nil
+}
+func (g *InMemoryShortlistGateway) DeleteShortlist(ctx context.Context, id string) error {
+	delete(g.shortlists, id)
+	return nil
+}
+
+func TestAddShortlistTalentRetriesConflicts(t *testing.T) {
+	ctx := context.Background()
+	talents := NewInMemoryTalentGateway()
+	talentId := createTestTalent(t, talents)
+	shortlists := NewInMemoryShortlistGateway()
+	created, err := NewCreateShortlistUseCase(ctx, shortlists).Execute(CreateShortlistInputDTO{Name: "Finalists", Owner: "ana"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clock := fixedClock(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+
+	shortlists.conflicts = shortlistRetries - 1
+	output, err := NewAddShortlistTalentUseCase(ctx, shortlists, talents, clock).Execute(AddShortlistTalentInputDTO{Id: created.Id, Owner: "ana", TalentId: talentId})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(output.TalentIds, []string{talentId}) || output.Version != 2 || !output.UpdatedAt.Equal(clock.Now()) {
+		t.Errorf("expected the talent to be added, got %+v", output)
+	}
+
+	shortlists.conflicts = shortlistRetries
+	_, err = NewRemoveShortlistTalentUseCase(ctx, shortlists, clock).Execute(RemoveShortlistTalentInputDTO{Id: created.Id, Owner: "ana", TalentId: talentId})
+	if !errors.Is(err, domain.ErrShortlistConflict) {
+		t.Errorf("expected ErrShortlistConflict after %d attempts, got %v", shortlistRetries, err)
+	}
+}
+
+func TestGetShortlistSkipsDeletedTalents(t *testing.T) {
+	ctx := context.Background()
+	talents := NewInMemoryTalentGateway()
+	talentId := createTestTalent(t, talents)
+	shortlists := NewInMemoryShortlistGateway()
+	shortlist, _ := domain.NewShortlist("Finalists", "ana", false)
+	_, _ = shortlist.Add("deleted", -1, time.Now())
+	_, _ = shortlist.Add(talentId, -1, time.Now())
+	_ = shortlists.SaveShortlist(ctx, shortlist)
+
+	output, err := NewGetShortlistUseCase(ctx, shortlists, talents).Execute(GetShortlistInputDTO{Id: shortlist.Id.String(), Owner: "ana"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(output.TalentIds) != 2 || len(output.Talents) != 1 || output.Talents[0].Id != talentId {
+		t.Errorf("expected only the existing talent to be resolved, got %+v", output)
+	}
+}
+
+func TestPrivateShortlistIsHiddenFromOtherOwners(t *testing.T) {
+	ctx := context.Background()
+	talents := NewInMemoryTalentGateway()
+	talentId := createTestTalent(t, talents)
+	shortlists := NewInMemoryShortlistGateway()
+	shortlist, _ := domain.NewShortlist("Finalists", "ana", false)
+	_ = shortlists.SaveShortlist(ctx, shortlist)
+	id := shortlist.Id.String()
+	clock := fixedClock(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC))
+
+	_, err := NewGetShortlistUseCase(ctx, shortlists, talents).Execute(GetShortlistInputDTO{Id: id, Owner: "bruno"})
+	if !errors.Is(err, domain.ErrShortlistNotFound) {
+		t.Errorf("expected ErrShortlistNotFound on get, got %v", err)
+	}
+	_, err = NewAddShortlistTalentUseCase(ctx, shortlists, talents, clock).Execute(AddShortlistTalentInputDTO{Id: id, TalentId: talentId})
+	if !errors.Is(err, domain.ErrShortlistNotFound) {
+		t.Errorf("expected ErrShortlistNotFound on add, got %v", err)
+	}
+	err = NewDeleteShortlistUseCase(ctx, shortlists).Execute(DeleteShortlistInputDTO{Id: id, Owner: "bruno"})
+	if !errors.Is(err, domain.ErrShortlistNotFound) {
+		t.Errorf("expected ErrShortlistNotFound on delete, got %v", err)
+	}
+	if _, err := shortlists.GetShortlistById(ctx, id); err != nil {
+		t.Errorf("expected the shortlist to be kept, got %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type GetStatsUseCase struct {
+	StatsGateway domain.StatsGateway
+	Clock        domain.Clock
+	Ctx          context.Context
+}
+
+func NewGetStatsUseCase(ctx context.Context, statsGateway domain.StatsGateway, clock domain.Clock) *GetStatsUseCase {
+	return &GetStatsUseCase{
+		Ctx:          ctx,
+		StatsGateway: statsGateway,
+		Clock:        clock,
+	}
+}
+
+type GetStatsInputDTO struct {
+	Bucket string
+	From   time.Time
+	To     time.Time
+	Top    int
+}
+
+type StatsBucketDTO struct {
+	Start time.Time `json:"start"`
+	Count int64     `json:"count"`
+}
+
+type StatsCountDTO struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+type GetStatsOutputDTO struct {
+	Bucket       string           `json:"bucket"`
+	From         time.Time        `json:"from"`
+	To           time.Time        `json:"to"`
+	Total        int64            `json:"total"`
+	Captured     []StatsBucketDTO `json:"captured"`
+	TopTags      []StatsCountDTO  `json:"top_tags"`
+	TopCompanies []StatsCountDTO  `json:"top_companies"`
+	TopRoles     []StatsCountDTO  `json:"top_roles"`
+	Funnel       []StatsCountDTO  `json:"funnel"`
+}
+
+const (
+	defaultStatsBuckets = 12
+	defaultStatsTop     = 10
+	maxStatsTop         = 50
+)
+
+func (uc *GetStatsUseCase) Execute(input GetStatsInputDTO) (*GetStatsOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "GetStatsUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *GetStatsUseCase) execute(ctx context.Context, input GetStatsInputDTO) (*GetStatsOutputDTO, error) {
+	query := defaultStatsQuery(input, uc.Clock.Now())
+	err := query.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := uc.StatsGateway.GetStats(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GetStatsOutputDTO{
+		Bucket:       query.Bucket,
+		From:         query.From,
+		To:           query.To,
+		Total:        stats.Total,
+		Captured:     make([]StatsBucketDTO, 0, len(stats.Captured)),
+		TopTags:      newStatsCounts(stats.TopTags),
+		TopCompanies: newStatsCounts(stats.TopCompanies),
+		TopRoles:     newStatsCounts(stats.TopRoles),
+		Funnel:       newStatsCounts(stats.Funnel),
+	}
+	for _, bucket := range stats.Captured {
+		output.Captured = append(output.Captured, StatsBucketDTO{Start: bucket.Start, Count: bucket.Count})
+	}
+	return output, nil
+}
+
+// defaultStatsQuery covers the last twelve buckets, up to the end of the
+// current day, when no range is given.
+func defaultStatsQuery(input GetStatsInputDTO, now time.Time) domain.StatsQuery {
+	query := domain.StatsQuery{
+		Bucket: input.Bucket,
+		From:   input.From.UTC(),
+		To:     input.To.UTC(),
+		TopN:   input.Top,
+	}
+	if query.Bucket == "" {
+		query.Bucket = domain.BucketWeek
+	}
+	if input.To.IsZero() {
+		query.To = domain.BucketStart(now, domain.BucketDay).AddDate(0, 0, 1)
+	}
+	if input.From.IsZero() {
+		from := domain.BucketStart(query.To.Add(-time.Nanosecond), query.Bucket)
+		switch query.Bucket {
+		case domain.BucketMonth:
+			query.From = from.AddDate(0, 1-defaultStatsBuckets, 0)
+		case domain.BucketWeek:
+			query.From = from.AddDate(0, 0, 7*(1-defaultStatsBuckets))
+		default:
+			query.From = from.AddDate(0, 0, 1-defaultStatsBuckets)
+		}
+	}
+	if query.TopN == 0 {
+		query.TopN = defaultStatsTop
+	}
+	query.TopN = min(query.TopN, maxStatsTop)
+	return query
+}
+
+func newStatsCounts(counts []domain.NamedCount) []StatsCountDTO {
+	output := make([]StatsCountDTO, 0, len(counts))
+	for _, c := range counts {
+		output = append(output, StatsCountDTO{Name: c.Name, Count: c.Count})
+	}
+	return output
+}
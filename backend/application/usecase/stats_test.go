@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func (g *InMemoryTalentGateway) GetStats(ctx context.Context, query domain.StatsQuery) (*domain.Stats, error) {
+	accumulator := domain.NewStatsAccumulator(query)
+	for _, talent := range g.talents {
+		accumulator.Add(talent)
+	}
+	return accumulator.Result(), nil
+}
+
+func TestGetStatsDefaults(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	createTestTalent(t, gateway)
+
+	now := time.Now().UTC()
+	output, err := NewGetStatsUseCase(ctx, gateway, fixedClock(now)).Execute(GetStatsInputDTO{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.Bucket != domain.BucketWeek {
+		t.Errorf("expected week buckets, got %s", output.Bucket)
+	}
+	if len(output.Captured) != 12 {
+		t.Errorf("expected 12 buckets, got %d", len(output.Captured))
+	}
+	if output.Total != 1 || output.Captured[len(output.Captured)-1].Count != 1 {
+		t.Errorf("expected the talent in the last bucket, got total %d", output.Total)
+	}
+	if len(output.TopTags) != 1 || output.TopTags[0].Name != "golang" {
+		t.Errorf("unexpected top tags %v", output.TopTags)
+	}
+	if output.Funnel[0].Name != domain.StageSourced || output.Funnel[0].Count != 1 {
+		t.Errorf("unexpected funnel %v", output.Funnel)
+	}
+}
+
+func TestGetStatsInvalidBucket(t *testing.T) {
+	_, err := NewGetStatsUseCase(context.Background(), NewInMemoryTalentGateway(), fixedClock(time.Now())).Execute(GetStatsInputDTO{Bucket: "year"})
+	if !errors.Is(err, domain.ErrInvalidStatsQuery) {
+		t.Fatalf("expected ErrInvalidStatsQuery, got %v", err)
+	}
+}
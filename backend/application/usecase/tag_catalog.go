@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/google/uuid"
+)
+
+// tagCatalog resolves talent tags against the catalog and keeps the usage
+// counts in step with the talents.
+type tagCatalog struct {
+	gateway domain.TagGateway
+}
+
+// resolve replaces aliases by their canonical names and drops duplicates.
+// Tags missing from the catalog are registered without a category.
+func (c tagCatalog) resolve(ctx context.Context, values []string) ([]string, error) {
+	var keys []string
+	for _, value := range values {
+		if key := domain.TagKey(value); key != "" && !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	tags, err := c.gateway.FindTagsByKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	var resolved []string
+	for _, value := range values {
+		if domain.TagKey(value) == "" {
+			continue
+		}
+		tag := findTag(tags, value)
+		if tag == nil {
+			tag, err = c.register(ctx, value)
+			if err != nil {
+				return nil, err
+			}
+			tags = append(tags, *tag)
+		}
+		if !slices.Contains(resolved, tag.Name) {
+			resolved = append(resolved, tag.Name)
+		}
+	}
+	return resolved, nil
+}
+
+func (c tagCatalog) register(ctx context.Context, value string) (*domain.Tag, error) {
+	tag, err := domain.NewTag(value, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	// the id derived from the name may belong to a tag renamed since then
+	_, err = c.gateway.GetTagById(ctx, tag.Id.String())
+	if err == nil {
+		tag.Id = uuid.New()
+	} else if !errors.Is(err, domain.ErrTagNotFound) {
+		return nil, err
+	}
+
+	err = c.gateway.SaveTag(ctx, *tag)
+	if err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("tag registered", "tag_id", tag.Id.String(), "name", tag.Name)
+	return tag, nil
+}
+
+// findTag prefers the tag named value over the ones having it as an alias,
+// which only happens while a merge is in progress.
+func findTag(tags []domain.Tag, value string) *domain.Tag {
+	var alias *domain.Tag
+	for i := range tags {
+		if domain.TagKey(tags[i].Name) == domain.TagKey(value) {
+			return &tags[i]
+		}
+		if alias == nil && tags[i].Matches(value) {
+			alias = &tags[i]
+		}
+	}
+	return alias
+}
+
+// countUsage adjusts the usage counts after a talent changed its tags from
+// before to after. The talent is already saved, so failures are only logged.
+func (c tagCatalog) countUsage(ctx context.Context, before []string, after []string) {
+	added := tagKeysMissing(after, before)
+	removed := tagKeysMissing(before, after)
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+
+	tags, err := c.gateway.FindTagsByKeys(ctx, append(added, removed...))
+	if err == nil {
+		deltas := make(map[string]int64)
+		for _, tag := range tags {
+			if slices.ContainsFunc(added, tag.Matches) {
+				deltas[tag.Id.String()]++
+			}
+			if slices.ContainsFunc(removed, tag.Matches) {
+				deltas[tag.Id.String()]--
+			}
+		}
+		err = c.gateway.AdjustUsage(ctx, deltas)
+	}
+	if err != nil {
+		logging.FromContext(ctx).Warn("tag usage counts not updated", "error", err)
+	}
+}
+
+// tagKeysMissing returns the keys of the tags in values that are not in
+// others.
+func tagKeysMissing(values []string, others []string) []string {
+	var keys []string
+	for _, value := range values {
+		key := domain.TagKey(value)
+		if key == "" || slices.Contains(keys, key) {
+			continue
+		}
+		if !slices.ContainsFunc(others, func(other string) bool { return domain.TagKey(other) == key }) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
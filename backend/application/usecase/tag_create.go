@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/google/uuid"
+)
+
+type CreateTagUseCase struct {
+	TagGateway domain.TagGateway
+	Ctx        context.Context
+}
+
+func NewCreateTagUseCase(ctx context.Context, tagGateway domain.TagGateway) *CreateTagUseCase {
+	return &CreateTagUseCase{
+		Ctx:        ctx,
+		TagGateway: tagGateway,
+	}
+}
+
+type CreateTagInputDTO struct {
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Aliases  []string `json:"aliases"`
+}
+
+type TagOutputDTO struct {
+	Id         string   `json:"id"`
+	Name       string   `json:"name"`
+	Category   string   `json:"category,omitempty"`
+	Aliases    []string `json:"aliases"`
+	UsageCount int64    `json:"usage_count"`
+}
+
+func newTagOutput(tag domain.Tag) TagOutputDTO {
+	aliases := tag.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return TagOutputDTO{
+		Id:         tag.Id.String(),
+		Name:       tag.Name,
+		Category:   tag.Category,
+		Aliases:    aliases,
+		UsageCount: tag.UsageCount,
+	}
+}
+
+func (uc *CreateTagUseCase) Execute(input CreateTagInputDTO) (*TagOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "CreateTagUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *CreateTagUseCase) execute(ctx context.Context, input CreateTagInputDTO) (*TagOutputDTO, error) {
+	tag, err := domain.NewTag(input.Name, input.Category, input.Aliases)
+	if err != nil {
+		return nil, err
+	}
+	err = checkTagKeys(ctx, uc.TagGateway, *tag)
+	if err != nil {
+		return nil, err
+	}
+	// the id derived from the name may belong to a tag renamed since then
+	_, err = uc.TagGateway.GetTagById(ctx, tag.Id.String())
+	if err == nil {
+		tag.Id = uuid.New()
+	} else if !errors.Is(err, domain.ErrTagNotFound) {
+		return nil, err
+	}
+
+	err = uc.TagGateway.SaveTag(ctx, *tag)
+	if err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("tag created", "tag_id", tag.Id.String(), "name", tag.Name)
+
+	output := newTagOutput(*tag)
+	return &output, nil
+}
+
+// checkTagKeys makes sure no other tag uses the name or aliases of tag.
+func checkTagKeys(ctx context.Context, gateway domain.TagGateway, tag domain.Tag) error {
+	existing, err := gateway.FindTagsByKeys(ctx, tag.Keys)
+	if err != nil {
+		return err
+	}
+	for _, other := range existing {
+		if other.Id != tag.Id {
+			return fmt.Errorf("%w: %s is already used by tag %s", domain.ErrTagConflict, other.Name, other.Id)
+		}
+	}
+	return nil
+}
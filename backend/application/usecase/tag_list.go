@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type ListTagsUseCase struct {
+	TagGateway domain.TagGateway
+	Ctx        context.Context
+}
+
+func NewListTagsUseCase(ctx context.Context, tagGateway domain.TagGateway) *ListTagsUseCase {
+	return &ListTagsUseCase{
+		Ctx:        ctx,
+		TagGateway: tagGateway,
+	}
+}
+
+// ListTagsInputDTO filters by a name prefix, for autocomplete.
+type ListTagsInputDTO struct {
+	Query    string
+	Category string
+	Limit    int
+}
+
+type ListTagsOutputDTO struct {
+	Tags []TagOutputDTO `json:"tags"`
+}
+
+func (uc *ListTagsUseCase) Execute(input ListTagsInputDTO) (*ListTagsOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "ListTagsUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *ListTagsUseCase) execute(ctx context.Context, input ListTagsInputDTO) (*ListTagsOutputDTO, error) {
+	if input.Category != "" && !slices.Contains(domain.TagCategories, input.Category) {
+		return nil, fmt.Errorf("%w: category must be one of %s", domain.ErrInvalidTag, strings.Join(domain.TagCategories, ", "))
+	}
+	if input.Limit <= 0 || input.Limit > 100 {
+		input.Limit = 20
+	}
+
+	tags, err := uc.TagGateway.SearchTags(ctx, input.Query, input.Category, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListTagsOutputDTO{Tags: make([]TagOutputDTO, 0, len(tags))}
+	for _, tag := range tags {
+		output.Tags = append(output.Tags, newTagOutput(tag))
+	}
+	return output, nil
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+const (
+	mergeBatchSize = 100
+	// mergeMaxRounds stops a merge that keeps losing version races, it can
+	// be run again to finish.
+	mergeMaxRounds = 1000
+)
+
+type MergeTagUseCase struct {
+	TagGateway    domain.TagGateway
+	TalentGateway domain.TalentGateway
+	Ctx           context.Context
+}
+
+func NewMergeTagUseCase(ctx context.Context, tagGateway domain.TagGateway, talentGateway domain.TalentGateway) *MergeTagUseCase {
+	return &MergeTagUseCase{
+		Ctx:           ctx,
+		TagGateway:    tagGateway,
+		TalentGateway: talentGateway,
+	}
+}
+
+// MergeTagInputDTO merges the tag Id into the tag Into.
+type MergeTagInputDTO struct {
+	Id   string `json:"-"`
+	Into string `json:"into"`
+}
+
+type MergeTagOutputDTO struct {
+	Tag            TagOutputDTO `json:"tag"`
+	TalentsUpdated int          `json:"talents_updated"`
+}
+
+func (uc *MergeTagUseCase) Execute(input MergeTagInputDTO) (*MergeTagOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "MergeTagUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+// execute moves the names to the target tag first, so new talents already
+// resolve to it, then rewrites the talents in batches and only deletes the
+// merged tag at the end. A merge interrupted halfway can be run again.
+func (uc *MergeTagUseCase) execute(ctx context.Context, input MergeTagInputDTO) (*MergeTagOutputDTO, error) {
+	if input.Into == "" || input.Into == input.Id {
+		return nil, fmt.Errorf("%w: into must be another tag", domain.ErrInvalidTag)
+	}
+	source, err := uc.TagGateway.GetTagById(ctx, input.Id)
+	if err != nil {
+		return nil, err
+	}
+	target, err := uc.TagGateway.GetTagById(ctx, input.Into)
+	if err != nil {
+		return nil, err
+	}
+
+	target.Absorb(*source)
+	err = uc.TagGateway.SaveTag(ctx, *target)
+	if err != nil {
+		return nil, err
+	}
+
+	values := append([]string{source.Name}, source.Aliases...)
+	updated, err := uc.rewriteTalents(ctx, values, *target)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.TagGateway.DeleteTag(ctx, source.Id.String())
+	if err != nil && !errors.Is(err, domain.ErrTagNotFound) {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("tag merged", "tag_id", source.Id.String(), "into", target.Id.String(), "talents_updated", updated)
+
+	// the counts absorbed while rewriting are not in the loaded target
+	merged, err := uc.TagGateway.GetTagById(ctx, target.Id.String())
+	if err != nil {
+		return nil, err
+	}
+	return &MergeTagOutputDTO{Tag: newTagOutput(*merged), TalentsUpdated: updated}, nil
+}
+
+func (uc *MergeTagUseCase) rewriteTalents(ctx context.Context, values []string, target domain.Tag) (int, error) {
+	updated := 0
+	for round := 0; round < mergeMaxRounds; round++ {
+		talents, err := uc.TalentGateway.GetTalentsByTags(ctx, values, mergeBatchSize)
+		if err != nil {
+			return updated, err
+		}
+		if len(talents) == 0 {
+			return updated, nil
+		}
+
+		usage := int64(0)
+		for _, talent := range talents {
+			hadTarget := talentHasTag(talent, target.Name)
+			if !talent.ReplaceTag(values, target.Name) {
+				continue
+			}
+			err = uc.TalentGateway.Save(ctx, &talent)
+			// changed in the meantime, picked up again by the next batch
+			if errors.Is(err, domain.ErrVersionConflict) {
+				continue
+			}
+			if err != nil {
+				return updated, err
+			}
+			updated++
+			if !hadTarget {
+				usage++
+			}
+		}
+
+		err = uc.TagGateway.AdjustUsage(ctx, map[string]int64{target.Id.String(): usage})
+		if err != nil {
+			return updated, err
+		}
+	}
+	return updated, fmt.Errorf("talents still tagged with %v after %d batches", values, mergeMaxRounds)
+}
+
+func talentHasTag(talent domain.Talent, name string) bool {
+	for _, tag := range talent.Tags {
+		if domain.TagKey(tag) == domain.TagKey(name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type SuggestTagsUseCase struct {
+	TagSuggester domain.TagSuggester
+	Ctx          context.Context
+}
+
+func NewSuggestTagsUseCase(ctx context.Context, tagSuggester domain.TagSuggester) *SuggestTagsUseCase {
+	return &SuggestTagsUseCase{
+		Ctx:          ctx,
+		TagSuggester: tagSuggester,
+	}
+}
+
+// SuggestTagsInputDTO takes the same fields CreateTalentUseCase looks at.
+type SuggestTagsInputDTO struct {
+	Headline     string `json:"headline"`
+	CurrentRole  string `json:"current_role"`
+	PossibleRole string `json:"possible_role"`
+}
+
+type SuggestTagsOutputDTO struct {
+	Tags []string `json:"tags"`
+}
+
+func (uc *SuggestTagsUseCase) Execute(input SuggestTagsInputDTO) (*SuggestTagsOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "SuggestTagsUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *SuggestTagsUseCase) execute(ctx context.Context, input SuggestTagsInputDTO) (*SuggestTagsOutputDTO, error) {
+	tags, err := uc.TagSuggester.SuggestTags(ctx, input.Headline, input.CurrentRole, input.PossibleRole)
+	if err != nil {
+		return nil, err
+	}
+	if tags == nil {
+		tags = []string{}
+	}
+	return &SuggestTagsOutputDTO{Tags: tags}, nil
+}
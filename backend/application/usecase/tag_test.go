@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/tagging"
+)
+
+type InMemoryTagGateway struct {
+	tags map[string]domain.Tag
+}
+
+func NewInMemoryTagGateway() *InMemoryTagGateway {
+	return &InMemoryTagGateway{
+		tags: make(map[string]domain.Tag),
+	}
+}
+
+func (g *InMemoryTagGateway) SaveTag(ctx context.Context, tag domain.Tag) error {
+	tag.UsageCount = g.tags[tag.Id.String()].UsageCount
+	g.tags[tag.Id.String()] = tag
+	return nil
+}
+func (g *InMemoryTagGateway) GetTagById(ctx context.Context, id string) (*domain.Tag, error) {
+	if tag, exists := g.tags[id]; exists {
+		return &tag, nil
+	}
+	return nil, domain.ErrTagNotFound
+}
+func (g *InMemoryTagGateway) FindTagsByKeys(ctx context.Context, keys []string) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	for _, tag := range g.tags {
+		if slices.ContainsFunc(keys, func(key string) bool { return slices.Contains(tag.Keys, key) }) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
+func (g *InMemoryTagGateway) SearchTags(ctx context.Context, prefix string, category string, limit int) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	for _, tag := range g.tags {
+		if strings.HasPrefix(domain.TagKey(tag.Name), domain.TagKey(prefix)) && (category == "" || tag.Category == category) {
+			tags = append(tags, tag)
+		}
+	}
+	slices.SortFunc(tags, func(a, b domain.Tag) int {
+		return cmp.Or(cmp.Compare(b.UsageCount, a.UsageCount), cmp.Compare(a.Name, b.Name))
+	})
+	return tags[:min(limit, len(tags))], nil
+}
+func (g *InMemoryTagGateway) GetAllTags(ctx context.Context) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	for _, tag := range g.tags {
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+func (g *InMemoryTagGateway) DeleteTag(ctx context.Context, id string) error {
+	if _, exists := g.tags[id]; !exists {
+		return domain.ErrTagNotFound
+	}
+	delete(g.tags, id)
+	return nil
+}
+func (g *InMemoryTagGateway) AdjustUsage(ctx context.Context, deltas map[string]int64) error {
+	for id, delta := range deltas {
+		if tag, exists := g.tags[id]; exists {
+			tag.UsageCount += delta
+			g.tags[id] = tag
+		}
+	}
+	return nil
+}
+
+func catalogSuggester(tags domain.TagGateway) domain.TagSuggester {
+	return tagging.NewSuggester(tags, domain.SystemClock{}, 0)
+}
+
+func createTestTag(t *testing.T, tags domain.TagGateway, name string, aliases ...string) TagOutputDTO {
+	output, err := NewCreateTagUseCase(context.Background(), tags).Execute(CreateTagInputDTO{
+		Name:     name,
+		Category: domain.TagCategoryLanguage,
+		Aliases:  aliases,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return *output
+}
+
+func createTaggedTalent(t *testing.T, talents domain.TalentGateway, tags domain.TagGateway, values ...string) string {
+	output, err := NewCreateTalentUseCase(context.Background(), talents, tags, catalogSuggester(tags)).Execute(CreateTalentInputDTO{
+		ProfileURL:   "https://linkedin.com/in/test",
+		PossibleRole: "Backend Engineer",
+		FullName:     "John Doe",
+		Headline:     "Senior Developer",
+		Tags:         values,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return output.Id
+}
+
+func TestCreateTalentResolvesTagAliases(t *testing.T) {
+	talents := NewInMemoryTalentGateway()
+	tags := NewInMemoryTagGateway()
+	golang := createTestTag(t, tags, "Go", "golang", "go-lang")
+
+	id := createTaggedTalent(t, talents, tags, "GoLang", "go lang", "Kubernetes")
+
+	saved := talents.talents[id]
+	if !slices.Equal(saved.Tags, []string{"Go", "Kubernetes"}) {
+		t.Errorf("expected [Go Kubernetes], got %v", saved.Tags)
+	}
+	if tags.tags[golang.Id].UsageCount != 1 {
+		t.Errorf("expected Go to be used once, got %d", tags.tags[golang.Id].UsageCount)
+	}
+	registered, _ := tags.FindTagsByKeys(context.Background(), []string{"kubernetes"})
+	if len(registered) != 1 || registered[0].UsageCount != 1 || registered[0].Category != "" {
+		t.Errorf("expected Kubernetes to be registered without category, got %v", registered)
+	}
+}
+
+func TestTagUsageFollowsTalentChanges(t *testing.T) {
+	ctx := context.Background()
+	talents := NewInMemoryTalentGateway()
+	tags := NewInMemoryTagGateway()
+	golang := createTestTag(t, tags, "Go", "golang")
+	rust := createTestTag(t, tags, "Rust")
+	id := createTaggedTalent(t, talents, tags, "golang")
+
+	newTags := []string{"rust"}
+	_, err := NewPatchTalentUseCase(ctx, talents, tags).Execute(PatchTalentInputDTO{Id: id, Version: 1, Tags: &newTags})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tags.tags[golang.Id].UsageCount != 0 || tags.tags[rust.Id].UsageCount != 1 {
+		t.Errorf("expected usage to move from Go to Rust, got %d and %d", tags.tags[golang.Id].UsageCount, tags.tags[rust.Id].UsageCount)
+	}
+
+	err = NewDeleteTalentUseCase(ctx, talents, tags).Execute(DeleteTalentInputDTO{Id: id, Version: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tags.tags[rust.Id].UsageCount != 0 {
+		t.Errorf("expected Rust to be unused after delete, got %d", tags.tags[rust.Id].UsageCount)
+	}
+}
+
+func TestCreateTagConflict(t *testing.T) {
+	tags := NewInMemoryTagGateway()
+	createTestTag(t, tags, "Go", "golang")
+
+	_, err := NewCreateTagUseCase(context.Background(), tags).Execute(CreateTagInputDTO{Name: "Go-Lang"})
+	if !errors.Is(err, domain.ErrTagConflict) {
+		t.Fatalf("expected ErrTagConflict, got %v", err)
+	}
+	_, err = NewCreateTagUseCase(context.Background(), tags).Execute(CreateTagInputDTO{Name: "Elixir", Category: "hobby"})
+	if !errors.Is(err, domain.ErrInvalidTag) {
+		t.Fatalf("expected ErrInvalidTag, got %v", err)
+	}
+}
+
+func TestListTagsAutocomplete(t *testing.T) {
+	talents := NewInMemoryTalentGateway()
+	tags := NewInMemoryTagGateway()
+	createTestTag(t, tags, "Go")
+	createTestTag(t, tags, "GraphQL")
+	createTestTag(t, tags, "Rust")
+	createTaggedTalent(t, talents, tags, "GraphQL")
+
+	output, err := NewListTagsUseCase(context.Background(), tags).Execute(ListTagsInputDTO{Query: "g"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(output.Tags) != 2 || output.Tags[0].Name != "GraphQL" {
+		t.Errorf("expected GraphQL then Go, got %v", output.Tags)
+	}
+}
+
+func TestMergeTag(t *testing.T) {
+	ctx := context.Background()
+	talents := NewInMemoryTalentGateway()
+	tags := NewInMemoryTagGateway()
+	golang := createTestTag(t, tags, "golang", "go language")
+	id := createTaggedTalent(t, talents, tags, "golang", "docker")
+	both := createTaggedTalent(t, talents, tags, "golang")
+	goTag := createTestTag(t, tags, "Go")
+	patched := []string{"golang", "Go"}
+	_, err := NewPatchTalentUseCase(ctx, talents, tags).Execute(PatchTalentInputDTO{Id: both, Version: 1, Tags: &patched})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output, err := NewMergeTagUseCase(ctx, tags, talents).Execute(MergeTagInputDTO{Id: golang.Id, Into: goTag.Id})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.TalentsUpdated != 2 {
+		t.Errorf("expected 2 talents updated, got %d", output.TalentsUpdated)
+	}
+	if output.Tag.UsageCount != 2 {
+		t.Errorf("expected Go to be used twice, got %d", output.Tag.UsageCount)
+	}
+	if !slices.Equal(output.Tag.Aliases, []string{"golang", "go language"}) {
+		t.Errorf("expected the merged names as aliases, got %v", output.Tag.Aliases)
+	}
+	if _, exists := tags.tags[golang.Id]; exists {
+		t.Error("expected the merged tag to be deleted")
+	}
+	if !slices.Equal(talents.talents[id].Tags, []string{"Go", "docker"}) {
+		t.Errorf("expected [Go docker], got %v", talents.talents[id].Tags)
+	}
+	if !slices.Equal(talents.talents[both].Tags, []string{"Go"}) {
+		t.Errorf("expected duplicates to be dropped, got %v", talents.talents[both].Tags)
+	}
+
+	resolved := createTaggedTalent(t, talents, tags, "Go Language")
+	if !slices.Equal(talents.talents[resolved].Tags, []string{"Go"}) {
+		t.Errorf("expected new talents to resolve to Go, got %v", talents.talents[resolved].Tags)
+	}
+}
+
+func TestCreateTalentSuggestsTags(t *testing.T) {
+	talents := NewInMemoryTalentGateway()
+	tags := NewInMemoryTagGateway()
+	createTestTag(t, tags, "Go", "golang")
+	createTestTag(t, tags, "Kubernetes")
+	_, err := NewCreateTagUseCase(context.Background(), tags).Execute(CreateTagInputDTO{Name: "Senior", Category: domain.TagCategorySeniority})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output, err := NewCreateTalentUseCase(context.Background(), talents, tags, catalogSuggester(tags)).Execute(CreateTalentInputDTO{
+		ProfileURL:   "https://linkedin.com/in/test",
+		PossibleRole: "Backend Engineer",
+		FullName:     "John Doe",
+		Headline:     "Senior Golang Engineer | Kubernetes",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"Senior", "Go", "Kubernetes"}
+	if !slices.Equal(output.SuggestedTags, expected) || !slices.Equal(talents.talents[output.Id].Tags, expected) {
+		t.Errorf("expected %v, got %v and %v", expected, output.SuggestedTags, talents.talents[output.Id].Tags)
+	}
+
+	id := createTaggedTalent(t, talents, tags, "rust")
+	if !slices.Equal(talents.talents[id].Tags, []string{"rust"}) {
+		t.Errorf("expected the given tags to be kept, got %v", talents.talents[id].Tags)
+	}
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type UpdateTagUseCase struct {
+	TagGateway domain.TagGateway
+	Ctx        context.Context
+}
+
+func NewUpdateTagUseCase(ctx context.Context, tagGateway domain.TagGateway) *UpdateTagUseCase {
+	return &UpdateTagUseCase{
+		Ctx:        ctx,
+		TagGateway: tagGateway,
+	}
+}
+
+// UpdateTagInputDTO replaces the name, category and aliases. Renaming does not
+// rewrite the talents, so the previous name is kept as an alias.
+type UpdateTagInputDTO struct {
+	Id       string   `json:"-"`
+	Name     string   `json:"name"`
+	Category string   `json:"category"`
+	Aliases  []string `json:"aliases"`
+}
+
+func (uc *UpdateTagUseCase) Execute(input UpdateTagInputDTO) (*TagOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "UpdateTagUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *UpdateTagUseCase) execute(ctx context.Context, input UpdateTagInputDTO) (*TagOutputDTO, error) {
+	tag, err := uc.TagGateway.GetTagById(ctx, input.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	previousName := tag.Name
+	err = tag.Update(input.Name, input.Category, append(input.Aliases, previousName))
+	if err != nil {
+		return nil, err
+	}
+	err = checkTagKeys(ctx, uc.TagGateway, *tag)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.TagGateway.SaveTag(ctx, *tag)
+	if err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("tag updated", "tag_id", tag.Id.String(), "name", tag.Name)
+
+	output := newTagOutput(*tag)
+	return &output, nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type ArchiveTalentUseCase struct {
+	TalentGateway domain.TalentGateway
+	Ctx           context.Context
+}
+
+func NewArchiveTalentUseCase(ctx context.Context, talentGateway domain.TalentGateway) *ArchiveTalentUseCase {
+	return &ArchiveTalentUseCase{
+		Ctx:           ctx,
+		TalentGateway: talentGateway,
+	}
+}
+
+type ArchiveTalentInputDTO struct {
+	Id      string
+	Version int64
+}
+
+func (uc *ArchiveTalentUseCase) Execute(input ArchiveTalentInputDTO) (*UpdateTalentOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "ArchiveTalentUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *ArchiveTalentUseCase) execute(ctx context.Context, input ArchiveTalentInputDTO) (*UpdateTalentOutputDTO, error) {
+	talent, err := uc.TalentGateway.GetTalentById(ctx, input.Id)
+	if err != nil {
+		return nil, err
+	}
+	if talent == nil {
+		return nil, domain.ErrTalentNotFound
+	}
+	if talent.Version != input.Version {
+		return nil, domain.ErrVersionConflict
+	}
+
+	err = talent.Archive()
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.TalentGateway.Save(ctx, talent)
+	if err != nil {
+		return nil, err
+	}
+	logging.FromContext(ctx).Info("talent archived", "talent_id", talent.Id.String(), "version", talent.Version)
+
+	return &UpdateTalentOutputDTO{
+		Id:      talent.Id.String(),
+		Version: talent.Version,
+	}, nil
+}
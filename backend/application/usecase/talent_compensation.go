@@ -0,0 +1,36 @@
+package usecase
+
+import "github.com/allanCordeiro/talent-db/application/domain"
+
+// CompensationDTO carries Amount in minor units of Currency.
+type CompensationDTO struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Period   string `json:"period"`
+	Contract string `json:"contract"`
+}
+
+func (dto *CompensationDTO) toDomain() domain.Compensation {
+	if dto == nil {
+		return domain.Compensation{}
+	}
+	return domain.Compensation{
+		Amount:   dto.Amount,
+		Currency: dto.Currency,
+		Period:   dto.Period,
+		Contract: dto.Contract,
+	}
+}
+
+// newCompensationDTO hides the expectation from callers without access.
+func newCompensationDTO(compensation domain.Compensation, access bool) *CompensationDTO {
+	if !access || compensation.IsZero() {
+		return nil
+	}
+	return &CompensationDTO{
+		Amount:   compensation.Amount,
+		Currency: compensation.Currency,
+		Period:   compensation.Period,
+		Contract: compensation.Contract,
+	}
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type ContactsDTO struct {
+	Emails  []string           `json:"emails,omitempty"`
+	Phones  []string           `json:"phones,omitempty"`
+	GitHub  string             `json:"github,omitempty"`
+	Website string             `json:"website,omitempty"`
+	Socials []SocialProfileDTO `json:"socials,omitempty"`
+}
+
+type SocialProfileDTO struct {
+	Network string `json:"network,omitempty"`
+	URL     string `json:"url"`
+}
+
+func (dto ContactsDTO) toDomain() domain.Contacts {
+	contacts := domain.Contacts{
+		Emails:  dto.Emails,
+		Phones:  dto.Phones,
+		GitHub:  dto.GitHub,
+		Website: dto.Website,
+	}
+	for _, social := range dto.Socials {
+		contacts.Socials = append(contacts.Socials, domain.SocialProfile{Network: social.Network, URL: social.URL})
+	}
+	return contacts
+}
+
+func newContactsDTO(contacts domain.Contacts) ContactsDTO {
+	dto := ContactsDTO{
+		Emails:  contacts.Emails,
+		Phones:  contacts.Phones,
+		GitHub:  contacts.GitHub,
+		Website: contacts.Website,
+	}
+	for _, social := range contacts.Socials {
+		dto.Socials = append(dto.Socials, SocialProfileDTO{Network: social.Network, URL: social.URL})
+	}
+	return dto
+}
+
+// checkEmailsAvailable returns ErrContactConflict when another talent already
+// has one of the talent's emails. TalentGateway.Save enforces it atomically,
+// this check also covers talents saved before emails were reserved.
+func checkEmailsAvailable(ctx context.Context, gateway domain.TalentGateway, talent *domain.Talent) error {
+	keys := talent.Contacts.EmailKeys()
+	if len(keys) == 0 {
+		return nil
+	}
+	owners, err := gateway.FindTalentsByContact(ctx, keys, len(keys)+1)
+	if err != nil {
+		return err
+	}
+	for _, owner := range owners {
+		if owner.Id == talent.Id {
+			continue
+		}
+		for _, email := range talent.Contacts.Emails {
+			if slices.Contains(owner.Contacts.Emails, email) {
+				return fmt.Errorf("%w: %s is used by talent %s", domain.ErrContactConflict, email, owner.Id.String())
+			}
+		}
+	}
+	return nil
+}
+
+// findByContact looks the handle up as every kind of contact it could be.
+func findByContact(ctx context.Context, gateway domain.TalentGateway, handle string, limit int) ([]domain.Talent, error) {
+	keys := domain.ContactLookupKeys(handle)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: %q is not an email, phone, GitHub username or URL", domain.ErrInvalidContact, strings.TrimSpace(handle))
+	}
+	return gateway.FindTalentsByContact(ctx, keys, limit)
+}
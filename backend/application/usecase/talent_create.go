@@ -4,36 +4,70 @@ import (
 	"context"
 
 	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
 )
 
 type CreateTalentUseCase struct {
 	TalentGateway domain.TalentGateway
+	TagGateway    domain.TagGateway
+	TagSuggester  domain.TagSuggester
 	Ctx           context.Context
 }
 
-func NewCreateTalentUseCase(ctx context.Context, talentGateway domain.TalentGateway) *CreateTalentUseCase {
+func NewCreateTalentUseCase(ctx context.Context, talentGateway domain.TalentGateway, tagGateway domain.TagGateway,
+	tagSuggester domain.TagSuggester) *CreateTalentUseCase {
 	return &CreateTalentUseCase{
 		Ctx:           ctx,
 		TalentGateway: talentGateway,
+		TagGateway:    tagGateway,
+		TagSuggester:  tagSuggester,
 	}
 }
 
 type CreateTalentInputDTO struct {
-	ProfileURL     string   `json:"profile_url"`
-	PossibleRole   string   `json:"possible_role"`
-	FullName       string   `json:"full_name"`
-	Headline       string   `json:"headline"`
-	CurrentCompany string   `json:"current_company"`
-	CurrentRole    string   `json:"current_role"`
-	Tags           []string `json:"tags"`
-	Notes          string   `json:"notes"`
+	ProfileURL     string           `json:"profile_url"`
+	PossibleRole   string           `json:"possible_role"`
+	FullName       string           `json:"full_name"`
+	Headline       string           `json:"headline"`
+	CurrentCompany string           `json:"current_company"`
+	CurrentRole    string           `json:"current_role"`
+	Tags           []string         `json:"tags"`
+	Notes          string           `json:"notes"`
+	Contacts       ContactsDTO      `json:"contacts"`
+	Location       LocationDTO      `json:"location"`
+	Compensation   *CompensationDTO `json:"compensation"`
+	// CompensationAccess is set for recruiters and admins, the only callers
+	// allowed to read or change the compensation.
+	CompensationAccess bool `json:"-"`
 }
 
 type CreateTalentOutputDTO struct {
 	Id string
+	// SuggestedTags is set when the talent was created without tags and
+	// got the ones found in its headline and roles.
+	SuggestedTags []string
 }
 
 func (uc *CreateTalentUseCase) Execute(input CreateTalentInputDTO) (*CreateTalentOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "CreateTalentUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *CreateTalentUseCase) execute(ctx context.Context, input CreateTalentInputDTO) (*CreateTalentOutputDTO, error) {
+	var suggested []string
+	if len(input.Tags) == 0 {
+		suggested = uc.suggestTags(ctx, input)
+		input.Tags = suggested
+	}
+
+	catalog := tagCatalog{gateway: uc.TagGateway}
+	tags, err := catalog.resolve(ctx, input.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	talent, err := domain.Create(
 		input.ProfileURL,
 		input.PossibleRole,
@@ -41,20 +75,56 @@ func (uc *CreateTalentUseCase) Execute(input CreateTalentInputDTO) (*CreateTalen
 		input.Headline,
 		input.CurrentCompany,
 		input.CurrentRole,
-		input.Tags,
+		tags,
 		input.Notes,
 	)
 	if err != nil {
 		return nil, err
 	}
+	err = talent.SetContacts(input.Contacts.toDomain())
+	if err != nil {
+		return nil, err
+	}
+	err = talent.SetLocation(input.Location.toDomain())
+	if err != nil {
+		return nil, err
+	}
+	if input.Compensation != nil {
+		if !input.CompensationAccess {
+			return nil, domain.ErrCompensationForbidden
+		}
+		err = talent.SetCompensation(input.Compensation.toDomain())
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = checkEmailsAvailable(ctx, uc.TalentGateway, talent)
+	if err != nil {
+		return nil, err
+	}
 
-	err = uc.TalentGateway.Save(uc.Ctx, *talent)
+	err = uc.TalentGateway.Save(ctx, talent)
 	if err != nil {
 		return nil, err
 	}
+	catalog.countUsage(ctx, nil, talent.Tags)
+
+	logging.FromContext(ctx).Info("talent created", "talent_id", talent.Id.String(), "possible_role", talent.PossibleRole)
 
 	output := &CreateTalentOutputDTO{
-		Id: talent.Id.String(),
+		Id:            talent.Id.String(),
+		SuggestedTags: suggested,
 	}
 	return output, nil
 }
+
+// suggestTags does not fail the capture: the talent is created without tags
+// when the catalog cannot be read.
+func (uc *CreateTalentUseCase) suggestTags(ctx context.Context, input CreateTalentInputDTO) []string {
+	tags, err := uc.TagSuggester.SuggestTags(ctx, input.Headline, input.CurrentRole, input.PossibleRole)
+	if err != nil {
+		logging.FromContext(ctx).Warn("tag suggestion failed", "error", err)
+		return nil
+	}
+	return tags
+}
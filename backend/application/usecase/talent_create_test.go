@@ -2,6 +2,8 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 
 	"github.com/allanCordeiro/talent-db/application/domain"
@@ -9,6 +11,7 @@ import (
 
 type InMemoryTalentGateway struct {
 	talents map[string]domain.Talent
+	outbox  []domain.TalentEvent
 }
 
 func NewInMemoryTalentGateway() *InMemoryTalentGateway {
@@ -17,28 +20,88 @@ func NewInMemoryTalentGateway() *InMemoryTalentGateway {
 	}
 }
 
-func (g *InMemoryTalentGateway) Save(ctx context.Context, talent domain.Talent) error {
-	g.talents[talent.Id.String()] = talent
+func (g *InMemoryTalentGateway) Save(ctx context.Context, talent *domain.Talent) error {
+	if g.talents[talent.Id.String()].Version != talent.Version {
+		return domain.ErrVersionConflict
+	}
+	talent.Version++
+	g.outbox = append(g.outbox, talent.PullEvents()...)
+	g.talents[talent.Id.String()] = *talent
+	return nil
+}
+func (g *InMemoryTalentGateway) Delete(ctx context.Context, id string, version int64) error {
+	talent, exists := g.talents[id]
+	if !exists {
+		return domain.ErrTalentNotFound
+	}
+	if talent.Version != version {
+		return domain.ErrVersionConflict
+	}
+	delete(g.talents, id)
+	g.outbox = append(g.outbox, domain.NewTalentEvent(domain.EventTalentDeleted, id, nil))
 	return nil
 }
-func (g *InMemoryTalentGateway) GetTalents(ctx context.Context, limit int, cursor string) ([]domain.Talent, string, error) {
+func (g *InMemoryTalentGateway) GetTalents(ctx context.Context, limit int, cursor string, sort domain.TalentSort) ([]domain.Talent, string, error) {
 	var talents []domain.Talent
 	for _, t := range g.talents {
 		talents = append(talents, t)
 	}
-	return talents, "", nil
+	slices.SortFunc(talents, func(a, b domain.Talent) int { return sort.Compare(&a, &b) })
+	if cursor != "" {
+		values, id, err := sort.DecodeCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		after := slices.IndexFunc(talents, func(t domain.Talent) bool { return sort.After(&t, values, id) })
+		if after < 0 {
+			after = len(talents)
+		}
+		talents = talents[after:]
+	}
+	if len(talents) < limit {
+		return talents, "", nil
+	}
+	next, err := sort.EncodeCursor(&talents[limit-1])
+	return talents[:limit], next, err
 }
 func (g *InMemoryTalentGateway) GetTalentById(ctx context.Context, id string) (*domain.Talent, error) {
 	if talent, exists := g.talents[id]; exists {
 		return &talent, nil
 	}
+	return nil, domain.ErrTalentNotFound
+}
+func (g *InMemoryTalentGateway) GetTalentsByTags(ctx context.Context, tags []string, limit int) ([]domain.Talent, error) {
+	var talents []domain.Talent
+	for _, t := range g.talents {
+		if len(talents) < limit && slices.ContainsFunc(t.Tags, func(tag string) bool { return slices.Contains(tags, tag) }) {
+			talents = append(talents, t)
+		}
+	}
+	return talents, nil
+}
+func (g *InMemoryTalentGateway) FindTalentsByContact(ctx context.Context, keys []string, limit int) ([]domain.Talent, error) {
+	var talents []domain.Talent
+	for _, t := range g.talents {
+		if len(talents) < limit && slices.ContainsFunc(t.ContactKeys, func(key string) bool { return slices.Contains(keys, key) }) {
+			talents = append(talents, t)
+		}
+	}
+	return talents, nil
+}
+func (g *InMemoryTalentGateway) Ping(ctx context.Context) error {
+	return nil
+}
+
+type noSuggestions struct{}
+
+func (noSuggestions) SuggestTags(ctx context.Context, texts ...string) ([]string, error) {
 	return nil, nil
 }
 
 func TestCreateTalentSuccess(t *testing.T) {
 	ctx := context.Background()
 	gateway := NewInMemoryTalentGateway()
-	useCase := NewCreateTalentUseCase(ctx, gateway)
+	useCase := NewCreateTalentUseCase(ctx, gateway, NewInMemoryTagGateway(), noSuggestions{})
 
 	input := CreateTalentInputDTO{
 		ProfileURL:     "https://linkedin.com/in/test",
@@ -68,7 +131,7 @@ func TestCreateTalentSuccess(t *testing.T) {
 func TestCreateTalentSavedData(t *testing.T) {
 	ctx := context.Background()
 	gateway := NewInMemoryTalentGateway()
-	useCase := NewCreateTalentUseCase(ctx, gateway)
+	useCase := NewCreateTalentUseCase(ctx, gateway, NewInMemoryTagGateway(), noSuggestions{})
 
 	input := CreateTalentInputDTO{
 		FullName:       "Jane Smith",
@@ -91,3 +154,52 @@ func TestCreateTalentSavedData(t *testing.T) {
 		t.Errorf("expected FullName %s, got %s", input.FullName, saved.FullName)
 	}
 }
+
+func TestCreateTalentRaisesEvent(t *testing.T) {
+	gateway := NewInMemoryTalentGateway()
+	useCase := NewCreateTalentUseCase(context.Background(), gateway, NewInMemoryTagGateway(), noSuggestions{})
+
+	output, err := useCase.Execute(CreateTalentInputDTO{
+		ProfileURL:   "https://linkedin.com/in/test",
+		PossibleRole: "Backend Engineer",
+		FullName:     "John Doe",
+		Headline:     "Senior Developer",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(gateway.outbox) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(gateway.outbox))
+	}
+	event := gateway.outbox[0]
+	if event.Type != domain.EventTalentCreated || event.TalentId != output.Id {
+		t.Errorf("unexpected event %+v", event)
+	}
+}
+
+func TestCreateTalentRejectsEmailOfAnotherTalent(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	input := CreateTalentInputDTO{
+		ProfileURL:   "https://linkedin.com/in/jane",
+		PossibleRole: "Backend Engineer",
+		FullName:     "Jane Doe",
+		Headline:     "Developer",
+		Contacts:     ContactsDTO{Emails: []string{"jane@example.com"}},
+	}
+	_, err := NewCreateTalentUseCase(ctx, gateway, NewInMemoryTagGateway(), noSuggestions{}).Execute(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	input.ProfileURL = "https://linkedin.com/in/jane-doe"
+	input.Contacts.Emails = []string{"JANE@example.com "}
+	_, err = NewCreateTalentUseCase(ctx, gateway, NewInMemoryTagGateway(), noSuggestions{}).Execute(input)
+	if !errors.Is(err, domain.ErrContactConflict) {
+		t.Fatalf("expected ErrContactConflict, got %v", err)
+	}
+	if len(gateway.talents) != 1 {
+		t.Errorf("expected the duplicate not to be saved, got %d talents", len(gateway.talents))
+	}
+}
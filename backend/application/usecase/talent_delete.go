@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type DeleteTalentUseCase struct {
+	TalentGateway domain.TalentGateway
+	TagGateway    domain.TagGateway
+	Ctx           context.Context
+}
+
+func NewDeleteTalentUseCase(ctx context.Context, talentGateway domain.TalentGateway, tagGateway domain.TagGateway) *DeleteTalentUseCase {
+	return &DeleteTalentUseCase{
+		Ctx:           ctx,
+		TalentGateway: talentGateway,
+		TagGateway:    tagGateway,
+	}
+}
+
+type DeleteTalentInputDTO struct {
+	Id      string
+	Version int64
+}
+
+func (uc *DeleteTalentUseCase) Execute(input DeleteTalentInputDTO) error {
+	ctx, span := tracer.Start(uc.Ctx, "DeleteTalentUseCase.Execute")
+	err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return err
+}
+
+func (uc *DeleteTalentUseCase) execute(ctx context.Context, input DeleteTalentInputDTO) error {
+	// loaded first for the tags to uncount
+	talent, err := uc.TalentGateway.GetTalentById(ctx, input.Id)
+	if err != nil {
+		return err
+	}
+	if talent.Version != input.Version {
+		return domain.ErrVersionConflict
+	}
+
+	err = uc.TalentGateway.Delete(ctx, input.Id, input.Version)
+	if err != nil {
+		return err
+	}
+	tagCatalog{gateway: uc.TagGateway}.countUsage(ctx, talent.Tags, nil)
+
+	logging.FromContext(ctx).Info("talent deleted", "talent_id", input.Id)
+	return nil
+}
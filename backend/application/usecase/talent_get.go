@@ -19,27 +19,47 @@ func NewGetTalentUseCase(ctx context.Context, talentGateway domain.TalentGateway
 }
 
 type GetTalentInputDTO struct {
-	Id string
+	Id                 string
+	CompensationAccess bool
 }
 
 type GetTalentOutputDTO struct {
-	Id             string   `json:"id"`
-	ProfileURL     string   `json:"profile_url"`
-	PossibleRole   string   `json:"possible_role"`
-	FullName       string   `json:"full_name"`
-	Headline       string   `json:"headline"`
-	CurrentCompany string   `json:"current_company"`
-	CurrentRole    string   `json:"current_role"`
-	Tags           []string `json:"tags"`
-	Notes          string   `json:"notes"`
-	CapturedAt     string   `json:"captured_at"`
+	Id             string           `json:"id"`
+	ProfileURL     string           `json:"profile_url"`
+	PossibleRole   string           `json:"possible_role"`
+	FullName       string           `json:"full_name"`
+	Headline       string           `json:"headline"`
+	CurrentCompany string           `json:"current_company"`
+	CurrentRole    string           `json:"current_role"`
+	Tags           []string         `json:"tags"`
+	Notes          string           `json:"notes"`
+	CapturedAt     string           `json:"captured_at"`
+	UpdatedAt      string           `json:"updated_at,omitempty"`
+	Role           RoleProfileDTO   `json:"role"`
+	Contacts       ContactsDTO      `json:"contacts"`
+	Location       LocationDTO      `json:"location"`
+	Compensation   *CompensationDTO `json:"compensation,omitempty"`
+	Stage          string           `json:"stage"`
+	ArchivedAt     string           `json:"archived_at,omitempty"`
+	Version        int64            `json:"version"`
+	Scorecards     *ScorecardsDTO   `json:"scorecards,omitempty"`
 }
 
 func (uc *GetTalentUseCase) Execute(input GetTalentInputDTO) (*GetTalentOutputDTO, error) {
-	talent, err := uc.TalentGateway.GetTalentById(uc.Ctx, input.Id)
+	ctx, span := tracer.Start(uc.Ctx, "GetTalentUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *GetTalentUseCase) execute(ctx context.Context, input GetTalentInputDTO) (*GetTalentOutputDTO, error) {
+	talent, err := uc.TalentGateway.GetTalentById(ctx, input.Id)
 	if err != nil {
 		return nil, err
 	}
+	if talent == nil {
+		return nil, domain.ErrTalentNotFound
+	}
 	output := &GetTalentOutputDTO{
 		Id:             talent.Id.String(),
 		ProfileURL:     talent.ProfileURL,
@@ -51,6 +71,19 @@ func (uc *GetTalentUseCase) Execute(input GetTalentInputDTO) (*GetTalentOutputDT
 		Tags:           talent.Tags,
 		Notes:          talent.Notes,
 		CapturedAt:     talent.CapturedAt.String(),
+		Role:           newRoleProfileDTO(talent.NormalizedRole()),
+		Contacts:       newContactsDTO(talent.Contacts),
+		Location:       newLocationDTO(talent.Location),
+		Compensation:   newCompensationDTO(talent.Compensation, input.CompensationAccess),
+		Stage:          talent.CurrentStage(),
+		Version:        talent.Version,
+		Scorecards:     newScorecardsDTO(talent.Scorecards),
+	}
+	if !talent.UpdatedAt.IsZero() {
+		output.UpdatedAt = talent.UpdatedAt.String()
+	}
+	if talent.IsArchived() {
+		output.ArchivedAt = talent.ArchivedAt.String()
 	}
 	return output, nil
 }
@@ -2,7 +2,6 @@ package usecase
 
 import (
 	"context"
-	"strings"
 
 	"github.com/allanCordeiro/talent-db/application/domain"
 )
@@ -20,24 +19,51 @@ func NewListTalentUseCase(ctx context.Context, talentGateway domain.TalentGatewa
 }
 
 type ListTalentsInputDTO struct {
-	Limit        int
-	Cursor       string
-	Name         string
-	PossibleRole string
-	Tags         []string
+	Limit  int
+	Cursor string
+	// Contact looks talents up by an email, phone, GitHub username or profile
+	// URL instead of paging through all of them.
+	Contact string
+	Filter  domain.TalentFilter
+	// Sort is a key like rating or -captured_at, the default.
+	Sort string
+	// CompensationAccess is needed for compensation filters and to see the
+	// expectations.
+	CompensationAccess bool
 }
 
 type TalentDTO struct {
-	Id             string   `json:"id"`
-	ProfileURL     string   `json:"profile_url"`
-	PossibleRole   string   `json:"possible_role"`
-	FullName       string   `json:"full_name"`
-	Headline       string   `json:"headline"`
-	CurrentCompany string   `json:"current_company"`
-	CurrentRole    string   `json:"current_role"`
-	Tags           []string `json:"tags"`
-	Notes          string   `json:"notes"`
-	CapturedAt     string   `json:"captured_at"`
+	Id             string           `json:"id"`
+	ProfileURL     string           `json:"profile_url"`
+	PossibleRole   string           `json:"possible_role"`
+	FullName       string           `json:"full_name"`
+	Headline       string           `json:"headline"`
+	CurrentCompany string           `json:"current_company"`
+	CurrentRole    string           `json:"current_role"`
+	Tags           []string         `json:"tags"`
+	Notes          string           `json:"notes"`
+	CapturedAt     string           `json:"captured_at"`
+	Role           RoleProfileDTO   `json:"role"`
+	Contacts       ContactsDTO      `json:"contacts"`
+	Location       LocationDTO      `json:"location"`
+	Compensation   *CompensationDTO `json:"compensation,omitempty"`
+	Stage          string           `json:"stage"`
+	Archived       bool             `json:"archived,omitempty"`
+	Scorecards     *ScorecardsDTO   `json:"scorecards,omitempty"`
+}
+
+type RoleProfileDTO struct {
+	Function   string `json:"function,omitempty"`
+	Seniority  string `json:"seniority,omitempty"`
+	Management bool   `json:"management"`
+}
+
+func newRoleProfileDTO(role domain.RoleProfile) RoleProfileDTO {
+	return RoleProfileDTO{
+		Function:   role.Function,
+		Seniority:  role.Seniority,
+		Management: role.Management,
+	}
 }
 
 type ListTalentsOutputDTO struct {
@@ -46,44 +72,50 @@ type ListTalentsOutputDTO struct {
 }
 
 func (uc *ListTalentUseCase) Execute(input ListTalentsInputDTO) (*ListTalentsOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "ListTalentUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *ListTalentUseCase) execute(ctx context.Context, input ListTalentsInputDTO) (*ListTalentsOutputDTO, error) {
 	if input.Limit <= 0 || input.Limit > 50 {
 		input.Limit = 50
 	}
+	if input.Filter.UsesCompensation() && !input.CompensationAccess {
+		return nil, domain.ErrCompensationForbidden
+	}
+	filter := input.Filter.Normalize()
+	err := filter.Validate()
+	if err != nil {
+		return nil, err
+	}
+	sort, err := domain.ParseTalentSort(input.Sort)
+	if err != nil {
+		return nil, err
+	}
 
-	talents, nextCursor, err := uc.TalentGateway.GetTalents(uc.Ctx, input.Limit, input.Cursor)
+	var talents []domain.Talent
+	var nextCursor string
+	if input.Contact != "" {
+		talents, err = findByContact(ctx, uc.TalentGateway, input.Contact, input.Limit)
+	} else {
+		talents, nextCursor, err = uc.TalentGateway.GetTalents(ctx, input.Limit, input.Cursor, sort)
+	}
 	if err != nil {
 		return &ListTalentsOutputDTO{}, err
 	}
 
 	var filtered []domain.Talent
-	nameFilter := strings.ToLower(strings.TrimSpace(input.Name))
-	possibleRoleFilter := strings.ToLower(strings.TrimSpace(input.PossibleRole))
-
 	for _, t := range talents {
-		if nameFilter != "" && !strings.Contains(strings.ToLower(t.FullName), nameFilter) {
-			continue
+		if filter.Matches(t) {
+			filtered = append(filtered, t)
 		}
-		if possibleRoleFilter != "" && !strings.Contains(strings.ToLower(t.PossibleRole), possibleRoleFilter) {
-			continue
-		}
-
-		filtered = append(filtered, t)
 	}
 
 	var talentDTOs []TalentDTO
 	for _, t := range filtered {
-		talentDTOs = append(talentDTOs, TalentDTO{
-			Id:             t.Id.String(),
-			ProfileURL:     t.ProfileURL,
-			PossibleRole:   t.PossibleRole,
-			FullName:       t.FullName,
-			Headline:       t.Headline,
-			CurrentCompany: t.CurrentCompany,
-			CurrentRole:    t.CurrentRole,
-			Tags:           t.Tags,
-			Notes:          t.Notes,
-			CapturedAt:     t.CapturedAt.String(),
-		})
+		talentDTOs = append(talentDTOs, newTalentDTO(t, input.CompensationAccess))
 	}
 
 	return &ListTalentsOutputDTO{
@@ -92,15 +124,24 @@ func (uc *ListTalentUseCase) Execute(input ListTalentsInputDTO) (*ListTalentsOut
 	}, nil
 }
 
-// buscar por tags. por enquanto não será implementado
-// func normalizeTags(tags []string) []string {
-// 	normalized := make([]string, 0, len(tags))
-// 	for _, tag := range tags {
-// 		tt := strings.ToLower(strings.TrimSpace(tag))
-// 		if tt != "" {
-// 			continue
-// 		}
-// 		normalized = append(normalized, tt)
-// 	}
-// 	return normalized
-// }
+func newTalentDTO(t domain.Talent, compensationAccess bool) TalentDTO {
+	return TalentDTO{
+		Id:             t.Id.String(),
+		ProfileURL:     t.ProfileURL,
+		PossibleRole:   t.PossibleRole,
+		FullName:       t.FullName,
+		Headline:       t.Headline,
+		CurrentCompany: t.CurrentCompany,
+		CurrentRole:    t.CurrentRole,
+		Tags:           t.Tags,
+		Notes:          t.Notes,
+		CapturedAt:     t.CapturedAt.String(),
+		Role:           newRoleProfileDTO(t.NormalizedRole()),
+		Contacts:       newContactsDTO(t.Contacts),
+		Location:       newLocationDTO(t.Location),
+		Compensation:   newCompensationDTO(t.Compensation, compensationAccess),
+		Stage:          t.CurrentStage(),
+		Archived:       t.IsArchived(),
+		Scorecards:     newScorecardsDTO(t.Scorecards),
+	}
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+func TestListTalentsByNormalizedRole(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	for _, role := range []string{"Sr. Dev Backend", "Desenvolvedor Backend Pleno", "Senior Frontend Engineer"} {
+		_, err := NewCreateTalentUseCase(ctx, gateway, NewInMemoryTagGateway(), noSuggestions{}).Execute(CreateTalentInputDTO{
+			ProfileURL:   "https://linkedin.com/in/test",
+			PossibleRole: role,
+			FullName:     "John Doe",
+			Headline:     "Developer",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	output, err := NewListTalentUseCase(ctx, gateway).Execute(ListTalentsInputDTO{
+		Filter: domain.TalentFilter{Function: domain.FunctionBackend, Seniority: domain.SenioritySenior},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(output.Talents) != 1 || output.Talents[0].PossibleRole != "Sr. Dev Backend" {
+		t.Fatalf("expected only the senior backend talent, got %+v", output.Talents)
+	}
+	if output.Talents[0].Role != (RoleProfileDTO{Function: domain.FunctionBackend, Seniority: domain.SenioritySenior}) {
+		t.Errorf("unexpected role %+v", output.Talents[0].Role)
+	}
+
+	_, err = NewListTalentUseCase(ctx, gateway).Execute(ListTalentsInputDTO{Filter: domain.TalentFilter{Seniority: "principal"}})
+	if !errors.Is(err, domain.ErrInvalidRoleProfile) {
+		t.Errorf("expected ErrInvalidRoleProfile, got %v", err)
+	}
+}
+
+func TestListTalentsByContact(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	id := createTestTalent(t, gateway)
+	contacts := ContactsDTO{GitHub: "johndoe"}
+	_, err := NewPatchTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(PatchTalentInputDTO{Id: id, Version: 1, Contacts: &contacts})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	createTestTalent(t, gateway)
+
+	for _, handle := range []string{"https://github.com/JohnDoe", "@johndoe"} {
+		output, err := NewListTalentUseCase(ctx, gateway).Execute(ListTalentsInputDTO{Contact: handle})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(output.Talents) != 1 || output.Talents[0].Id != id || output.Talents[0].Contacts.GitHub != "johndoe" {
+			t.Errorf("%q: expected only the talent with the GitHub profile, got %+v", handle, output.Talents)
+		}
+	}
+
+	_, err = NewListTalentUseCase(ctx, gateway).Execute(ListTalentsInputDTO{Contact: "not a handle"})
+	if !errors.Is(err, domain.ErrInvalidContact) {
+		t.Errorf("expected ErrInvalidContact, got %v", err)
+	}
+}
+
+func TestListTalentsByLocation(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	locations := []LocationDTO{
+		{City: "Recife", State: "PE", Country: "BR", WorkModel: domain.WorkModelRemote},
+		{City: "Lisboa", Country: "PT", Timezone: "Europe/Lisbon", WorkModel: domain.WorkModelHybrid, OpenToRelocation: true},
+		{City: "Berlin", Country: "DE", WorkModel: domain.WorkModelOnSite},
+	}
+	for _, location := range locations {
+		_, err := NewCreateTalentUseCase(ctx, gateway, NewInMemoryTagGateway(), noSuggestions{}).Execute(CreateTalentInputDTO{
+			ProfileURL:   "https://linkedin.com/in/" + location.City,
+			PossibleRole: "Backend Engineer",
+			FullName:     "John Doe",
+			Headline:     "Developer",
+			Location:     location,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	output, err := NewListTalentUseCase(ctx, gateway).Execute(ListTalentsInputDTO{
+		Filter: domain.TalentFilter{Location: domain.LocationFilter{Countries: []string{"BR", "PT"}, OpenToRelocation: true}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(output.Talents) != 1 || output.Talents[0].Location.City != "Lisboa" {
+		t.Fatalf("expected only the talent in Lisboa, got %+v", output.Talents)
+	}
+
+	_, err = NewListTalentUseCase(ctx, gateway).Execute(ListTalentsInputDTO{
+		Filter: domain.TalentFilter{Location: domain.LocationFilter{Countries: []string{"Brazil"}}},
+	})
+	if !errors.Is(err, domain.ErrInvalidLocation) {
+		t.Errorf("expected ErrInvalidLocation, got %v", err)
+	}
+}
+
+func TestListTalentsByCompensation(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	for _, amount := range []int64{800000, 1200000, 2000000} {
+		_, err := NewCreateTalentUseCase(ctx, gateway, NewInMemoryTagGateway(), noSuggestions{}).Execute(CreateTalentInputDTO{
+			ProfileURL:         "https://linkedin.com/in/test",
+			PossibleRole:       "Backend Engineer",
+			FullName:           "John Doe",
+			Headline:           "Developer",
+			Compensation:       &CompensationDTO{Amount: amount, Currency: "BRL", Period: "monthly", Contract: "pj"},
+			CompensationAccess: true,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	filter := domain.TalentFilter{Compensation: domain.CompensationFilter{Currency: "BRL", Min: 1000000, Max: 1500000}}
+
+	_, err := NewListTalentUseCase(ctx, gateway).Execute(ListTalentsInputDTO{Filter: filter})
+	if !errors.Is(err, domain.ErrCompensationForbidden) {
+		t.Fatalf("expected ErrCompensationForbidden, got %v", err)
+	}
+
+	output, err := NewListTalentUseCase(ctx, gateway).Execute(ListTalentsInputDTO{Filter: filter, CompensationAccess: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(output.Talents) != 1 || output.Talents[0].Compensation.Amount != 1200000 {
+		t.Errorf("expected only the R$ 12.000 expectation, got %+v", output.Talents)
+	}
+}
+
+func TestListTalentsBySearchQuery(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	var ids []string
+	for _, name := range []string{"Ana Souza", "Bruno Lima"} {
+		output, err := NewCreateTalentUseCase(ctx, gateway, NewInMemoryTagGateway(), noSuggestions{}).Execute(CreateTalentInputDTO{
+			ProfileURL:   "https://linkedin.com/in/" + name,
+			PossibleRole: "Data Engineer",
+			FullName:     name,
+			Headline:     "Engenharia de Dados",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, output.Id)
+	}
+	talent := gateway.talents[ids[1]]
+	talent.AddCVKeywords(domain.SearchKeywords("Pipelines com Apache Airflow", 0))
+	gateway.talents[ids[1]] = talent
+
+	output, err := NewListTalentUseCase(ctx, gateway).Execute(ListTalentsInputDTO{Filter: domain.TalentFilter{Query: "engenharia airflow"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(output.Talents) != 1 || output.Talents[0].Id != ids[1] {
+		t.Errorf("expected only the talent with Airflow in the CV, got %+v", output.Talents)
+	}
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import "github.com/allanCordeiro/talent-db/application/domain"
+
+type LocationDTO struct {
+	City             string `json:"city,omitempty"`
+	State            string `json:"state,omitempty"`
+	Country          string `json:"country,omitempty"`
+	Timezone         string `json:"timezone,omitempty"`
+	WorkModel        string `json:"work_model,omitempty"`
+	OpenToRelocation bool   `json:"open_to_relocation"`
+}
+
+func (dto LocationDTO) toDomain() domain.Location {
+	return domain.Location{
+		City:             dto.City,
+		State:            dto.State,
+		Country:          dto.Country,
+		Timezone:         dto.Timezone,
+		WorkModel:        dto.WorkModel,
+		OpenToRelocation: dto.OpenToRelocation,
+	}
+}
+
+func newLocationDTO(location domain.Location) LocationDTO {
+	return LocationDTO{
+		City:             location.City,
+		State:            location.State,
+		Country:          location.Country,
+		Timezone:         location.Timezone,
+		WorkModel:        location.WorkModel,
+		OpenToRelocation: location.OpenToRelocation,
+	}
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type PatchTalentUseCase struct {
+	TalentGateway domain.TalentGateway
+	TagGateway    domain.TagGateway
+	Ctx           context.Context
+}
+
+func NewPatchTalentUseCase(ctx context.Context, talentGateway domain.TalentGateway, tagGateway domain.TagGateway) *PatchTalentUseCase {
+	return &PatchTalentUseCase{
+		Ctx:           ctx,
+		TalentGateway: talentGateway,
+		TagGateway:    tagGateway,
+	}
+}
+
+// PatchTalentInputDTO only changes the fields that are present in the request.
+type PatchTalentInputDTO struct {
+	Id             string       `json:"-"`
+	Version        int64        `json:"-"`
+	ProfileURL     *string      `json:"profile_url"`
+	PossibleRole   *string      `json:"possible_role"`
+	FullName       *string      `json:"full_name"`
+	Headline       *string      `json:"headline"`
+	CurrentCompany *string      `json:"current_company"`
+	CurrentRole    *string      `json:"current_role"`
+	Tags           *[]string    `json:"tags"`
+	Notes          *string      `json:"notes"`
+	Contacts       *ContactsDTO `json:"contacts"`
+	Location       *LocationDTO `json:"location"`
+	// Compensation clears the expectation when sent as an empty object.
+	Compensation *CompensationDTO `json:"compensation"`
+	Stage        *string          `json:"stage"`
+	// CompensationAccess is set for recruiters and admins, the only callers
+	// allowed to read or change the compensation.
+	CompensationAccess bool `json:"-"`
+}
+
+func (input PatchTalentInputDTO) changesProfile() bool {
+	return input.ProfileURL != nil || input.PossibleRole != nil || input.FullName != nil || input.Headline != nil ||
+		input.CurrentCompany != nil || input.CurrentRole != nil || input.Tags != nil || input.Notes != nil ||
+		input.Contacts != nil || input.Location != nil || input.Compensation != nil
+}
+
+func (uc *PatchTalentUseCase) Execute(input PatchTalentInputDTO) (*UpdateTalentOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "PatchTalentUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *PatchTalentUseCase) execute(ctx context.Context, input PatchTalentInputDTO) (*UpdateTalentOutputDTO, error) {
+	talent, err := uc.TalentGateway.GetTalentById(ctx, input.Id)
+	if err != nil {
+		return nil, err
+	}
+	if talent == nil {
+		return nil, domain.ErrTalentNotFound
+	}
+	if talent.Version != input.Version {
+		return nil, domain.ErrVersionConflict
+	}
+	if input.Compensation != nil && !input.CompensationAccess {
+		return nil, domain.ErrCompensationForbidden
+	}
+
+	catalog := tagCatalog{gateway: uc.TagGateway}
+	previousTags := talent.Tags
+	if input.changesProfile() {
+		tags := talent.Tags
+		if input.Tags != nil {
+			tags, err = catalog.resolve(ctx, *input.Tags)
+			if err != nil {
+				return nil, err
+			}
+		}
+		err = talent.Update(
+			valueOr(input.ProfileURL, talent.ProfileURL),
+			valueOr(input.PossibleRole, talent.PossibleRole),
+			valueOr(input.FullName, talent.FullName),
+			valueOr(input.Headline, talent.Headline),
+			valueOr(input.CurrentCompany, talent.CurrentCompany),
+			valueOr(input.CurrentRole, talent.CurrentRole),
+			tags,
+			valueOr(input.Notes, talent.Notes),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if input.Contacts != nil {
+		err = talent.SetContacts(input.Contacts.toDomain())
+		if err != nil {
+			return nil, err
+		}
+		err = checkEmailsAvailable(ctx, uc.TalentGateway, talent)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if input.Location != nil {
+		err = talent.SetLocation(input.Location.toDomain())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if input.Compensation != nil {
+		err = talent.SetCompensation(input.Compensation.toDomain())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if input.Stage != nil {
+		err = talent.ChangeStage(*input.Stage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = uc.TalentGateway.Save(ctx, talent)
+	if err != nil {
+		return nil, err
+	}
+	catalog.countUsage(ctx, previousTags, talent.Tags)
+	logging.FromContext(ctx).Info("talent updated", "talent_id", talent.Id.String(), "version", talent.Version)
+
+	return &UpdateTalentOutputDTO{
+		Id:      talent.Id.String(),
+		Version: talent.Version,
+	}, nil
+}
+
+func valueOr(value *string, fallback string) string {
+	if value == nil {
+		return fallback
+	}
+	return *value
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type UpdateTalentUseCase struct {
+	TalentGateway domain.TalentGateway
+	TagGateway    domain.TagGateway
+	Ctx           context.Context
+}
+
+func NewUpdateTalentUseCase(ctx context.Context, talentGateway domain.TalentGateway, tagGateway domain.TagGateway) *UpdateTalentUseCase {
+	return &UpdateTalentUseCase{
+		Ctx:           ctx,
+		TalentGateway: talentGateway,
+		TagGateway:    tagGateway,
+	}
+}
+
+type UpdateTalentInputDTO struct {
+	Id             string      `json:"-"`
+	Version        int64       `json:"-"`
+	ProfileURL     string      `json:"profile_url"`
+	PossibleRole   string      `json:"possible_role"`
+	FullName       string      `json:"full_name"`
+	Headline       string      `json:"headline"`
+	CurrentCompany string      `json:"current_company"`
+	CurrentRole    string      `json:"current_role"`
+	Tags           []string    `json:"tags"`
+	Notes          string      `json:"notes"`
+	Contacts       ContactsDTO `json:"contacts"`
+	Location       LocationDTO `json:"location"`
+	// Compensation is kept as is when the caller has no access to it.
+	Compensation *CompensationDTO `json:"compensation"`
+	// CompensationAccess is set for recruiters and admins, the only callers
+	// allowed to read or change the compensation.
+	CompensationAccess bool `json:"-"`
+}
+
+type UpdateTalentOutputDTO struct {
+	Id      string
+	Version int64
+}
+
+func (uc *UpdateTalentUseCase) Execute(input UpdateTalentInputDTO) (*UpdateTalentOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "UpdateTalentUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *UpdateTalentUseCase) execute(ctx context.Context, input UpdateTalentInputDTO) (*UpdateTalentOutputDTO, error) {
+	talent, err := uc.TalentGateway.GetTalentById(ctx, input.Id)
+	if err != nil {
+		return nil, err
+	}
+	if talent == nil {
+		return nil, domain.ErrTalentNotFound
+	}
+	if talent.Version != input.Version {
+		return nil, domain.ErrVersionConflict
+	}
+	if input.Compensation != nil && !input.CompensationAccess {
+		return nil, domain.ErrCompensationForbidden
+	}
+
+	catalog := tagCatalog{gateway: uc.TagGateway}
+	tags, err := catalog.resolve(ctx, input.Tags)
+	if err != nil {
+		return nil, err
+	}
+	previousTags := talent.Tags
+
+	err = talent.Update(
+		input.ProfileURL,
+		input.PossibleRole,
+		input.FullName,
+		input.Headline,
+		input.CurrentCompany,
+		input.CurrentRole,
+		tags,
+		input.Notes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = talent.SetContacts(input.Contacts.toDomain())
+	if err != nil {
+		return nil, err
+	}
+	err = talent.SetLocation(input.Location.toDomain())
+	if err != nil {
+		return nil, err
+	}
+	if input.CompensationAccess {
+		err = talent.SetCompensation(input.Compensation.toDomain())
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = checkEmailsAvailable(ctx, uc.TalentGateway, talent)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.TalentGateway.Save(ctx, talent)
+	if err != nil {
+		return nil, err
+	}
+	catalog.countUsage(ctx, previousTags, talent.Tags)
+	logging.FromContext(ctx).Info("talent updated", "talent_id", talent.Id.String(), "version", talent.Version)
+
+	return &UpdateTalentOutputDTO{
+		Id:      talent.Id.String(),
+		Version: talent.Version,
+	}, nil
+}
@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+func createTestTalent(t *testing.T, gateway domain.TalentGateway) string {
+	output, err := NewCreateTalentUseCase(context.Background(), gateway, NewInMemoryTagGateway(), noSuggestions{}).Execute(CreateTalentInputDTO{
+		ProfileURL:   "https://linkedin.com/in/test",
+		PossibleRole: "Backend Engineer",
+		FullName:     "John Doe",
+		Headline:     "Senior Developer",
+		Tags:         []string{"golang"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return output.Id
+}
+
+func TestUpdateTalentIncrementsVersion(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	id := createTestTalent(t, gateway)
+
+	output, err := NewUpdateTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(UpdateTalentInputDTO{
+		Id:           id,
+		Version:      1,
+		ProfileURL:   "https://linkedin.com/in/test",
+		PossibleRole: "Staff Engineer",
+		FullName:     "John Doe",
+		Headline:     "Senior Developer",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.Version != 2 {
+		t.Errorf("expected version 2, got %d", output.Version)
+	}
+
+	saved := gateway.talents[id]
+	if saved.PossibleRole != "Staff Engineer" {
+		t.Errorf("expected PossibleRole Staff Engineer, got %s", saved.PossibleRole)
+	}
+	if saved.UpdatedAt.IsZero() {
+		t.Error("expected UpdatedAt to be set")
+	}
+}
+
+func TestUpdateTalentStaleVersion(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	id := createTestTalent(t, gateway)
+
+	_, err := NewUpdateTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(UpdateTalentInputDTO{
+		Id:           id,
+		Version:      7,
+		ProfileURL:   "https://linkedin.com/in/test",
+		PossibleRole: "Staff Engineer",
+		FullName:     "John Doe",
+		Headline:     "Senior Developer",
+	})
+	if !errors.Is(err, domain.ErrVersionConflict) {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+}
+
+func TestPatchTalentKeepsMissingFields(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	id := createTestTalent(t, gateway)
+
+	notes := "Talked on the phone"
+	_, err := NewPatchTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(PatchTalentInputDTO{
+		Id:      id,
+		Version: 1,
+		Notes:   &notes,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	saved := gateway.talents[id]
+	if saved.Notes != notes {
+		t.Errorf("expected Notes %s, got %s", notes, saved.Notes)
+	}
+	if saved.FullName != "John Doe" {
+		t.Errorf("expected FullName John Doe, got %s", saved.FullName)
+	}
+	if len(saved.Tags) != 1 {
+		t.Errorf("expected tags to be kept, got %v", saved.Tags)
+	}
+}
+
+func TestDeleteTalentStaleVersion(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	id := createTestTalent(t, gateway)
+
+	err := NewDeleteTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(DeleteTalentInputDTO{Id: id, Version: 2})
+	if !errors.Is(err, domain.ErrVersionConflict) {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+
+	err = NewDeleteTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(DeleteTalentInputDTO{Id: id, Version: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, exists := gateway.talents[id]; exists {
+		t.Error("expected talent to be deleted")
+	}
+	if last := gateway.outbox[len(gateway.outbox)-1]; last.Type != domain.EventTalentDeleted {
+		t.Errorf("expected a talent.deleted event, got %s", last.Type)
+	}
+}
+
+func TestPatchTalentStageOnly(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	id := createTestTalent(t, gateway)
+
+	stage := domain.StageInterviewing
+	_, err := NewPatchTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(PatchTalentInputDTO{
+		Id:      id,
+		Version: 1,
+		Stage:   &stage,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	event := gateway.outbox[len(gateway.outbox)-1]
+	if len(gateway.outbox) != 2 || event.Type != domain.EventTalentStageChanged {
+		t.Fatalf("expected only a talent.stage_changed event after creation, got %d events", len(gateway.outbox))
+	}
+	if event.FromStage != domain.StageSourced || event.ToStage != domain.StageInterviewing {
+		t.Errorf("expected sourced -> interviewing, got %s -> %s", event.FromStage, event.ToStage)
+	}
+}
+
+func TestArchiveTalent(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	id := createTestTalent(t, gateway)
+
+	output, err := NewArchiveTalentUseCase(ctx, gateway).Execute(ArchiveTalentInputDTO{Id: id, Version: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	saved := gateway.talents[id]
+	if !saved.IsArchived() {
+		t.Error("expected talent to be archived")
+	}
+
+	_, err = NewArchiveTalentUseCase(ctx, gateway).Execute(ArchiveTalentInputDTO{Id: id, Version: output.Version})
+	if !errors.Is(err, domain.ErrTalentArchived) {
+		t.Fatalf("expected ErrTalentArchived, got %v", err)
+	}
+
+	list, err := NewListTalentUseCase(ctx, gateway).Execute(ListTalentsInputDTO{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(list.Talents) != 0 {
+		t.Errorf("expected archived talent to be hidden, got %d talents", len(list.Talents))
+	}
+}
+
+func TestPatchTalentContacts(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	id := createTestTalent(t, gateway)
+
+	contacts := ContactsDTO{Emails: []string{"john@example.com"}, Phones: []string{"(11) 3456-7890"}}
+	_, err := NewPatchTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(PatchTalentInputDTO{
+		Id:       id,
+		Version:  1,
+		Contacts: &contacts,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the talent keeps its own email on the next change
+	_, err = NewPatchTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(PatchTalentInputDTO{
+		Id:       id,
+		Version:  2,
+		Contacts: &contacts,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	saved := gateway.talents[id]
+	if len(saved.Contacts.Phones) != 1 || saved.Contacts.Phones[0] != "+551134567890" {
+		t.Errorf("expected the phone in E.164, got %v", saved.Contacts.Phones)
+	}
+	if saved.FullName != "John Doe" {
+		t.Errorf("expected FullName John Doe, got %s", saved.FullName)
+	}
+
+	invalid := ContactsDTO{Emails: []string{"john"}}
+	_, err = NewPatchTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(PatchTalentInputDTO{
+		Id:       id,
+		Version:  3,
+		Contacts: &invalid,
+	})
+	if !errors.Is(err, domain.ErrInvalidContact) {
+		t.Errorf("expected ErrInvalidContact, got %v", err)
+	}
+}
+
+func TestCompensationNeedsAccess(t *testing.T) {
+	ctx := context.Background()
+	gateway := NewInMemoryTalentGateway()
+	id := createTestTalent(t, gateway)
+	compensation := &CompensationDTO{Amount: 1500000, Currency: "BRL", Period: "monthly", Contract: "clt"}
+
+	_, err := NewPatchTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(PatchTalentInputDTO{
+		Id: id, Version: 1, Compensation: compensation,
+	})
+	if !errors.Is(err, domain.ErrCompensationForbidden) {
+		t.Fatalf("expected ErrCompensationForbidden, got %v", err)
+	}
+
+	_, err = NewPatchTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(PatchTalentInputDTO{
+		Id: id, Version: 1, Compensation: compensation, CompensationAccess: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// a full update without access keeps the compensation it cannot see
+	_, err = NewUpdateTalentUseCase(ctx, gateway, NewInMemoryTagGateway()).Execute(UpdateTalentInputDTO{
+		Id:           id,
+		Version:      2,
+		ProfileURL:   "https://linkedin.com/in/test",
+		PossibleRole: "Staff Engineer",
+		FullName:     "John Doe",
+		Headline:     "Senior Developer",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gateway.talents[id].Compensation.Amount != 1500000 {
+		t.Errorf("expected the compensation to be kept, got %+v", gateway.talents[id].Compensation)
+	}
+
+	output, _ := NewGetTalentUseCase(ctx, gateway).Execute(GetTalentInputDTO{Id: id})
+	if output.Compensation != nil {
+		t.Errorf("expected the compensation to be hidden, got %+v", output.Compensation)
+	}
+	output, _ = NewGetTalentUseCase(ctx, gateway).Execute(GetTalentInputDTO{Id: id, CompensationAccess: true})
+	if output.Compensation == nil || output.Compensation.Currency != "BRL" {
+		t.Errorf("expected the compensation, got %+v", output.Compensation)
+	}
+}
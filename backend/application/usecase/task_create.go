@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type CreateTaskUseCase struct {
+	TalentGateway domain.TalentGateway
+	TaskGateway   domain.TaskGateway
+	Ctx           context.Context
+}
+
+func NewCreateTaskUseCase(ctx context.Context, talentGateway domain.TalentGateway, taskGateway domain.TaskGateway) *CreateTaskUseCase {
+	return &CreateTaskUseCase{
+		Ctx:           ctx,
+		TalentGateway: talentGateway,
+		TaskGateway:   taskGateway,
+	}
+}
+
+type CreateTaskInputDTO struct {
+	TalentId string    `json:"-"`
+	Assignee string    `json:"assignee"`
+	Note     string    `json:"note"`
+	DueAt    time.Time `json:"due_at"`
+}
+
+type TaskOutputDTO struct {
+	Id          string     `json:"id"`
+	TalentId    string     `json:"talent_id"`
+	Assignee    string     `json:"assignee"`
+	Note        string     `json:"note"`
+	DueAt       time.Time  `json:"due_at"`
+	Done        bool       `json:"done"`
+	Overdue     bool       `json:"overdue"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+func newTaskOutput(task domain.FollowUpTask) TaskOutputDTO {
+	output := TaskOutputDTO{
+		Id:        task.Id.String(),
+		TalentId:  task.TalentId,
+		Assignee:  task.Assignee,
+		Note:      task.Note,
+		DueAt:     task.DueAt,
+		Done:      task.Done,
+		Overdue:   task.Overdue,
+		CreatedAt: task.CreatedAt,
+	}
+	if !task.CompletedAt.IsZero() {
+		output.CompletedAt = &task.CompletedAt
+	}
+	return output
+}
+
+func (uc *CreateTaskUseCase) Execute(input CreateTaskInputDTO) (*TaskOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "CreateTaskUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *CreateTaskUseCase) execute(ctx context.Context, input CreateTaskInputDTO) (*TaskOutputDTO, error) {
+	_, err := uc.TalentGateway.GetTalentById(ctx, input.TalentId)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := domain.NewFollowUpTask(input.TalentId, input.Assignee, input.Note, input.DueAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.TaskGateway.SaveTask(ctx, *task)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Info("task created", "task_id", task.Id.String(), "talent_id", task.TalentId, "assignee", task.Assignee)
+	output := newTaskOutput(*task)
+	return &output, nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type ListTasksUseCase struct {
+	TaskGateway domain.TaskGateway
+	Ctx         context.Context
+}
+
+func NewListTasksUseCase(ctx context.Context, taskGateway domain.TaskGateway) *ListTasksUseCase {
+	return &ListTasksUseCase{
+		Ctx:         ctx,
+		TaskGateway: taskGateway,
+	}
+}
+
+type ListTasksInputDTO struct {
+	TalentId  string
+	Assignee  string
+	DueBefore time.Time
+	Done      *bool
+	Limit     int
+}
+
+type ListTasksOutputDTO struct {
+	Tasks []TaskOutputDTO `json:"tasks"`
+}
+
+func (uc *ListTasksUseCase) Execute(input ListTasksInputDTO) (*ListTasksOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "ListTasksUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *ListTasksUseCase) execute(ctx context.Context, input ListTasksInputDTO) (*ListTasksOutputDTO, error) {
+	if input.Limit <= 0 || input.Limit > 200 {
+		input.Limit = 50
+	}
+
+	tasks, err := uc.TaskGateway.GetTasks(ctx, domain.TaskFilter{
+		TalentId:  input.TalentId,
+		Assignee:  input.Assignee,
+		DueBefore: input.DueBefore,
+		Done:      input.Done,
+		Limit:     input.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListTasksOutputDTO{Tasks: make([]TaskOutputDTO, 0, len(tasks))}
+	for _, task := range tasks {
+		output.Tasks = append(output.Tasks, newTaskOutput(task))
+	}
+	return output, nil
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type UpdateTaskUseCase struct {
+	TaskGateway domain.TaskGateway
+	Clock       domain.Clock
+	Ctx         context.Context
+}
+
+func NewUpdateTaskUseCase(ctx context.Context, taskGateway domain.TaskGateway, clock domain.Clock) *UpdateTaskUseCase {
+	return &UpdateTaskUseCase{
+		Ctx:         ctx,
+		TaskGateway: taskGateway,
+		Clock:       clock,
+	}
+}
+
+// UpdateTaskInputDTO only changes the fields that are present in the request.
+type UpdateTaskInputDTO struct {
+	Id       string     `json:"-"`
+	Assignee *string    `json:"assignee"`
+	Note     *string    `json:"note"`
+	DueAt    *time.Time `json:"due_at"`
+	Done     *bool      `json:"done"`
+}
+
+func (uc *UpdateTaskUseCase) Execute(input UpdateTaskInputDTO) (*TaskOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "UpdateTaskUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *UpdateTaskUseCase) execute(ctx context.Context, input UpdateTaskInputDTO) (*TaskOutputDTO, error) {
+	task, err := uc.TaskGateway.GetTaskById(ctx, input.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.Clock.Now()
+	task.Assignee = strings.TrimSpace(valueOr(input.Assignee, task.Assignee))
+	task.Note = valueOr(input.Note, task.Note)
+	if input.DueAt != nil {
+		task.Reschedule(*input.DueAt, now)
+	}
+	if input.Done != nil && *input.Done != task.Done {
+		if *input.Done {
+			task.Complete(now)
+		} else {
+			task.Reopen()
+		}
+	}
+	err = task.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.TaskGateway.SaveTask(ctx, *task)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Info("task updated", "task_id", input.Id, "done", task.Done)
+	output := newTaskOutput(*task)
+	return &output, nil
+}
@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/allanCordeiro/talent-db/application/usecase")
+
+func endSpan(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, domain.ErrTalentNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type CreateWebhookUseCase struct {
+	WebhookGateway domain.WebhookGateway
+	Ctx            context.Context
+}
+
+func NewCreateWebhookUseCase(ctx context.Context, webhookGateway domain.WebhookGateway) *CreateWebhookUseCase {
+	return &CreateWebhookUseCase{
+		Ctx:            ctx,
+		WebhookGateway: webhookGateway,
+	}
+}
+
+type CreateWebhookInputDTO struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret,omitempty"`
+	Events []string `json:"events"`
+}
+
+// CreateWebhookOutputDTO is the only response that carries the secret.
+type CreateWebhookOutputDTO struct {
+	Id     string `json:"id"`
+	Secret string `json:"secret"`
+}
+
+func (uc *CreateWebhookUseCase) Execute(input CreateWebhookInputDTO) (*CreateWebhookOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "CreateWebhookUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *CreateWebhookUseCase) execute(ctx context.Context, input CreateWebhookInputDTO) (*CreateWebhookOutputDTO, error) {
+	subscription, err := domain.NewWebhookSubscription(input.URL, input.Secret, input.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.WebhookGateway.SaveSubscription(ctx, *subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Info("webhook created", "webhook_id", subscription.Id.String(), "events", subscription.Events)
+	return &CreateWebhookOutputDTO{
+		Id:     subscription.Id.String(),
+		Secret: subscription.Secret,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type DeleteWebhookUseCase struct {
+	WebhookGateway domain.WebhookGateway
+	Ctx            context.Context
+}
+
+func NewDeleteWebhookUseCase(ctx context.Context, webhookGateway domain.WebhookGateway) *DeleteWebhookUseCase {
+	return &DeleteWebhookUseCase{
+		Ctx:            ctx,
+		WebhookGateway: webhookGateway,
+	}
+}
+
+type DeleteWebhookInputDTO struct {
+	Id string
+}
+
+func (uc *DeleteWebhookUseCase) Execute(input DeleteWebhookInputDTO) error {
+	ctx, span := tracer.Start(uc.Ctx, "DeleteWebhookUseCase.Execute")
+	err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return err
+}
+
+func (uc *DeleteWebhookUseCase) execute(ctx context.Context, input DeleteWebhookInputDTO) error {
+	err := uc.WebhookGateway.DeleteSubscription(ctx, input.Id)
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("webhook deleted", "webhook_id", input.Id)
+	return nil
+}
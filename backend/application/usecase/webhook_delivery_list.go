@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type ListWebhookDeliveriesUseCase struct {
+	WebhookGateway domain.WebhookGateway
+	Ctx            context.Context
+}
+
+func NewListWebhookDeliveriesUseCase(ctx context.Context, webhookGateway domain.WebhookGateway) *ListWebhookDeliveriesUseCase {
+	return &ListWebhookDeliveriesUseCase{
+		Ctx:            ctx,
+		WebhookGateway: webhookGateway,
+	}
+}
+
+type ListWebhookDeliveriesInputDTO struct {
+	SubscriptionId string
+	Status         string
+	Limit          int
+}
+
+type WebhookDeliveryOutputDTO struct {
+	Id             string `json:"id"`
+	SubscriptionId string `json:"subscription_id"`
+	EventId        string `json:"event_id"`
+	EventType      string `json:"event_type"`
+	Status         string `json:"status"`
+	Attempts       int    `json:"attempts"`
+	ResponseStatus int    `json:"response_status,omitempty"`
+	LastError      string `json:"last_error,omitempty"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+	NextAttemptAt  string `json:"next_attempt_at,omitempty"`
+}
+
+type ListWebhookDeliveriesOutputDTO struct {
+	Deliveries []WebhookDeliveryOutputDTO `json:"deliveries"`
+}
+
+func (uc *ListWebhookDeliveriesUseCase) Execute(input ListWebhookDeliveriesInputDTO) (*ListWebhookDeliveriesOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "ListWebhookDeliveriesUseCase.Execute")
+	output, err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *ListWebhookDeliveriesUseCase) execute(ctx context.Context, input ListWebhookDeliveriesInputDTO) (*ListWebhookDeliveriesOutputDTO, error) {
+	if input.Limit <= 0 || input.Limit > 200 {
+		input.Limit = 50
+	}
+
+	deliveries, err := uc.WebhookGateway.GetDeliveries(ctx, input.SubscriptionId, input.Status, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListWebhookDeliveriesOutputDTO{Deliveries: make([]WebhookDeliveryOutputDTO, 0, len(deliveries))}
+	for _, d := range deliveries {
+		item := WebhookDeliveryOutputDTO{
+			Id:             d.Id.String(),
+			SubscriptionId: d.SubscriptionId,
+			EventId:        d.EventId,
+			EventType:      d.EventType,
+			Status:         d.Status,
+			Attempts:       d.Attempts,
+			ResponseStatus: d.ResponseStatus,
+			LastError:      d.LastError,
+			CreatedAt:      d.CreatedAt.String(),
+			UpdatedAt:      d.UpdatedAt.String(),
+		}
+		if d.Status == domain.DeliveryPending {
+			item.NextAttemptAt = d.NextAttemptAt.String()
+		}
+		output.Deliveries = append(output.Deliveries, item)
+	}
+	return output, nil
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type RetryWebhookDeliveryUseCase struct {
+	WebhookGateway domain.WebhookGateway
+	Queue          domain.WebhookQueue
+	Ctx            context.Context
+}
+
+func NewRetryWebhookDeliveryUseCase(ctx context.Context, webhookGateway domain.WebhookGateway, queue domain.WebhookQueue) *RetryWebhookDeliveryUseCase {
+	return &RetryWebhookDeliveryUseCase{
+		Ctx:            ctx,
+		WebhookGateway: webhookGateway,
+		Queue:          queue,
+	}
+}
+
+type RetryWebhookDeliveryInputDTO struct {
+	Id string
+}
+
+// Execute moves a delivery back to pending and queues it right away.
+// Delivered ones are sent again too, which is handy to replay an event.
+func (uc *RetryWebhookDeliveryUseCase) Execute(input RetryWebhookDeliveryInputDTO) error {
+	ctx, span := tracer.Start(uc.Ctx, "RetryWebhookDeliveryUseCase.Execute")
+	err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return err
+}
+
+func (uc *RetryWebhookDeliveryUseCase) execute(ctx context.Context, input RetryWebhookDeliveryInputDTO) error {
+	delivery, err := uc.WebhookGateway.GetDeliveryById(ctx, input.Id)
+	if err != nil {
+		return err
+	}
+
+	delivery.Requeue()
+	err = uc.WebhookGateway.SaveDelivery(ctx, *delivery)
+	if err != nil {
+		return err
+	}
+	uc.Queue.Enqueue(*delivery)
+
+	logging.FromContext(ctx).Info("webhook delivery requeued", "delivery_id", input.Id, "subscription_id", delivery.SubscriptionId)
+	return nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+type ListWebhooksUseCase struct {
+	WebhookGateway domain.WebhookGateway
+	Ctx            context.Context
+}
+
+func NewListWebhooksUseCase(ctx context.Context, webhookGateway domain.WebhookGateway) *ListWebhooksUseCase {
+	return &ListWebhooksUseCase{
+		Ctx:            ctx,
+		WebhookGateway: webhookGateway,
+	}
+}
+
+type WebhookOutputDTO struct {
+	Id        string   `json:"id"`
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+	Active    bool     `json:"active"`
+	CreatedAt string   `json:"created_at"`
+}
+
+type ListWebhooksOutputDTO struct {
+	Webhooks []WebhookOutputDTO `json:"webhooks"`
+}
+
+func (uc *ListWebhooksUseCase) Execute() (*ListWebhooksOutputDTO, error) {
+	ctx, span := tracer.Start(uc.Ctx, "ListWebhooksUseCase.Execute")
+	output, err := uc.execute(ctx)
+	endSpan(span, err)
+	return output, err
+}
+
+func (uc *ListWebhooksUseCase) execute(ctx context.Context) (*ListWebhooksOutputDTO, error) {
+	subscriptions, err := uc.WebhookGateway.GetSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListWebhooksOutputDTO{Webhooks: make([]WebhookOutputDTO, 0, len(subscriptions))}
+	for _, s := range subscriptions {
+		output.Webhooks = append(output.Webhooks, WebhookOutputDTO{
+			Id:        s.Id.String(),
+			URL:       s.URL,
+			Events:    s.Events,
+			Active:    s.Active,
+			CreatedAt: s.CreatedAt.String(),
+		})
+	}
+	return output, nil
+}
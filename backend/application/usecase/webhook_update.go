@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+type UpdateWebhookUseCase struct {
+	WebhookGateway domain.WebhookGateway
+	Ctx            context.Context
+}
+
+func NewUpdateWebhookUseCase(ctx context.Context, webhookGateway domain.WebhookGateway) *UpdateWebhookUseCase {
+	return &UpdateWebhookUseCase{
+		Ctx:            ctx,
+		WebhookGateway: webhookGateway,
+	}
+}
+
+// UpdateWebhookInputDTO replaces url, events and active. The secret is kept.
+type UpdateWebhookInputDTO struct {
+	Id     string   `json:"-"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Active bool     `json:"active"`
+}
+
+func (uc *UpdateWebhookUseCase) Execute(input UpdateWebhookInputDTO) error {
+	ctx, span := tracer.Start(uc.Ctx, "UpdateWebhookUseCase.Execute")
+	err := uc.execute(ctx, input)
+	endSpan(span, err)
+	return err
+}
+
+func (uc *UpdateWebhookUseCase) execute(ctx context.Context, input UpdateWebhookInputDTO) error {
+	subscription, err := uc.WebhookGateway.GetSubscriptionById(ctx, input.Id)
+	if err != nil {
+		return err
+	}
+
+	subscription.URL = input.URL
+	subscription.Events = input.Events
+	subscription.Active = input.Active
+	err = subscription.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = uc.WebhookGateway.SaveSubscription(ctx, *subscription)
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("webhook updated", "webhook_id", input.Id, "active", input.Active)
+	return nil
+}
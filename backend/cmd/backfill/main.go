@@ -0,0 +1,32 @@
+// Command backfill fills the talent fields the list can be sorted by on
+// talents stored before those fields existed. It is safe to run more than
+// once.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/infra/config"
+	firestore_adapter "github.com/allanCordeiro/talent-db/infra/firestore"
+)
+
+func main() {
+	project := flag.String("project", firestore.DetectProjectID, "Firestore project")
+	flag.Parse()
+
+	ctx := context.Background()
+	client, err := firestore.NewClient(ctx, *project)
+	if err != nil {
+		log.Fatalf("failed to create firestore client: %v", err)
+	}
+	defer client.Close()
+
+	updated, err := firestore_adapter.NewTalentDB(client, config.FirestoreConfig{ProjectID: *project}).BackfillSortFields(ctx)
+	if err != nil {
+		log.Fatalf("backfill stopped after %d talents: %v", updated, err)
+	}
+	log.Printf("%d talents updated", updated)
+}
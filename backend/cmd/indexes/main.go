@@ -0,0 +1,36 @@
+// Command indexes writes the composite indexes of the talent sorts to
+// firestore.indexes.json.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	firestore_adapter "github.com/allanCordeiro/talent-db/infra/firestore"
+)
+
+func main() {
+	path := flag.String("file", "firestore.indexes.json", "index file to update")
+	flag.Parse()
+
+	file, err := firestore_adapter.ReadIndexFile(*path)
+	if err != nil {
+		log.Fatalf("failed to read %s: %v", *path, err)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(file.WithTalentSortIndexes())
+	if err != nil {
+		log.Fatalf("failed to encode the indexes: %v", err)
+	}
+	err = os.WriteFile(*path, buf.Bytes(), 0o644)
+	if err != nil {
+		log.Fatalf("failed to write %s: %v", *path, err)
+	}
+}
@@ -2,10 +2,26 @@ package main
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/storage"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/events"
+	"github.com/allanCordeiro/talent-db/application/extraction"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/application/reminders"
+	"github.com/allanCordeiro/talent-db/application/tagging"
+	"github.com/allanCordeiro/talent-db/infra/blob"
+	"github.com/allanCordeiro/talent-db/infra/config"
 	firestore_adapter "github.com/allanCordeiro/talent-db/infra/firestore"
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+	"github.com/allanCordeiro/talent-db/infra/tracing"
+	"github.com/allanCordeiro/talent-db/infra/webhook"
 	"github.com/allanCordeiro/talent-db/infra/webserver"
 )
 
@@ -16,14 +32,124 @@ import (
 // @contact.email  allan.cordeiro.santos@gmail.com
 // @BasePath /
 func main() {
+	err := run()
+	if err != nil {
+		slog.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		return fmt.Errorf("invalid configuration:\n%w", err)
+	}
+
+	logger, err := logging.New(os.Stdout, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
+	if cfg.Server.AdminToken == "" {
+		slog.Warn("admin token not configured, admin routes are disabled")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := shutdownTracing(context.Background())
+		if err != nil {
+			slog.Error("failed to flush traces", "error", err)
+		}
+	}()
 
-	ctx := context.Background()
-	fs, err := firestore.NewClient(ctx, "talent-479621")
+	projectID := cfg.Firestore.ProjectID
+	if projectID == "" {
+		projectID = firestore.DetectProjectID
+	}
+	fs, err := firestore.NewClient(ctx, projectID)
 	if err != nil {
-		log.Fatalf("failed to create firestore client: %v", err)
+		return fmt.Errorf("failed to create firestore client: %w", err)
 	}
+	defer fs.Close()
+
+	bus := events.NewBus(cfg.Events.Workers, cfg.Events.QueueSize)
+	relay := events.NewRelay(firestore_adapter.NewOutboxDB(fs), bus, cfg.Events.OutboxPollInterval, cfg.Events.OutboxBatchSize)
+
+	m := metrics.New()
+	talentdb := events.NewTalentGateway(metrics.NewTalentGateway(tracing.NewTalentGateway(firestore_adapter.NewTalentDB(fs, cfg.Firestore)), m), relay)
+	idempotencydb := metrics.NewIdempotencyGateway(tracing.NewIdempotencyGateway(firestore_adapter.NewIdempotencyDB(fs)), m)
+	webhookdb := firestore_adapter.NewWebhookDB(fs)
+	taskdb := firestore_adapter.NewTaskDB(fs)
+	statsdb := firestore_adapter.NewStatsDB(fs)
+	tagdb := firestore_adapter.NewTagDB(fs)
+	suggester := tagging.NewSuggester(tagdb, domain.SystemClock{}, cfg.Tags.SuggestionCacheTTL)
+	attachmentdb := firestore_adapter.NewAttachmentDB(fs)
+	savedsearchdb := firestore_adapter.NewSavedSearchDB(fs)
+	shortlistdb := firestore_adapter.NewShortlistDB(fs)
+	scorecarddb := firestore_adapter.NewScorecardDB(fs)
+	blobs, closeBlobs, err := newBlobStore(ctx, cfg.Attachments)
+	if err != nil {
+		return err
+	}
+	defer closeBlobs()
+	extractor := extraction.NewWorker(attachmentdb, blobs, talentdb, suggester, domain.SystemClock{},
+		cfg.Attachments.ExtractionWorkers, cfg.Attachments.ExtractionQueueSize, cfg.Attachments.ExtractionRescanInterval)
+
+	dispatcher := webhook.NewDispatcher(cfg.Webhook, webhookdb)
+	bus.Subscribe("webhooks", events.Async, dispatcher.Handle)
+
+	dispatcher.Start(ctx)
+	defer dispatcher.Wait()
+	bus.Start(ctx)
+	defer bus.Wait()
+	relay.Start(ctx)
+	defer relay.Wait()
+	extractor.Start(ctx)
+	defer extractor.Wait()
 
-	talentdb := firestore_adapter.NewTalentDB(fs, "talent-479621")
-	webserver.Serve(talentdb)
+	scheduler := reminders.NewScheduler(taskdb, relay, domain.SystemClock{}, cfg.Tasks.ScanInterval, cfg.Tasks.DigestHourUTC)
+	scheduler.Start(ctx)
+	defer scheduler.Wait()
 
+	server := webserver.NewServer(*cfg, m, webserver.Dependencies{
+		TalentGateway:      talentdb,
+		IdempotencyGateway: idempotencydb,
+		WebhookGateway:     webhookdb,
+		WebhookQueue:       dispatcher,
+		TaskGateway:        taskdb,
+		StatsGateway:       statsdb,
+		TagGateway:         tagdb,
+		TagSuggester:       suggester,
+		AttachmentGateway:  attachmentdb,
+		BlobStore:          blobs,
+		ExtractionQueue:    extractor,
+		SavedSearchGateway: savedsearchdb,
+		ShortlistGateway:   shortlistdb,
+		ScorecardGateway:   scorecarddb,
+		Clock:              domain.SystemClock{},
+	})
+
+	return server.Run(ctx)
+}
+
+func newBlobStore(ctx context.Context, cfg config.AttachmentsConfig) (domain.BlobStore, func(), error) {
+	if cfg.Store == "gcs" {
+		client, err := storage.NewClient(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create storage client: %w", err)
+		}
+		return blob.NewGCSStore(client, cfg.Bucket), func() { _ = client.Close() }, nil
+	}
+
+	store, err := blob.NewLocalStore(cfg.LocalDir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open attachments dir: %w", err)
+	}
+	return store, func() {}, nil
 }
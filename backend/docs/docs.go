@@ -18,9 +18,45 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/talent": {
+        "/admin/webhooks": {
+            "get": {
+                "description": "Retorna os webhooks cadastrados, sem o segredo.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Lista webhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListWebhooksOutputDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Cadastra um talento com os dados enviados no corpo da requisição.",
+                "description": "Registra uma URL que receberá os eventos de talentos assinados com HMAC-SHA256. O segredo só é devolvido nesta resposta.",
                 "consumes": [
                     "application/json"
                 ],
@@ -28,30 +64,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "talents"
+                    "webhooks"
                 ],
-                "summary": "Cria um talento",
+                "summary": "Cadastra um webhook",
                 "parameters": [
                     {
-                        "description": "Dados do talento",
-                        "name": "talent",
+                        "description": "URL, eventos (talent.created, talent.updated, talent.stage_changed, talent.archived, talent.deleted, task.overdue, task.digest ou *) e segredo opcional",
+                        "name": "webhook",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/usecase.CreateTalentInputDTO"
+                            "$ref": "#/definitions/usecase.CreateWebhookInputDTO"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Recurso criado",
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/webserver.CreateTalentResponse"
+                            "$ref": "#/definitions/usecase.CreateWebhookOutputDTO"
                         },
                         "headers": {
                             "Location": {
                                 "type": "string",
-                                "description": "URL do talento recém-criado"
+                                "description": "URL do webhook recém-criado"
                             }
                         }
                     },
@@ -61,6 +97,18 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "internal error",
                         "schema": {
@@ -70,34 +118,101 @@ const docTemplate = `{
                 }
             }
         },
-        "/talent/{id}": {
+        "/admin/webhooks/deliveries": {
             "get": {
-                "description": "Retorna os dados completos de um talento específico.",
+                "description": "Retorna o histórico de entregas mais recentes. Use status=dead para ver a lista de dead letter.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "talents"
+                    "webhooks"
                 ],
-                "summary": "Busca um talento",
+                "summary": "Lista entregas de webhooks",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ID do talento",
+                        "description": "ID do webhook",
+                        "name": "subscription_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "pending, delivered ou dead",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite de registros (padrão 50, máximo 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListWebhookDeliveriesOutputDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/webhooks/deliveries/{id}/retry": {
+            "post": {
+                "description": "Coloca a entrega de volta na fila com as tentativas zeradas. Serve para esvaziar a lista de dead letter.",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Reenvia uma entrega de webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da entrega",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "accepted",
                         "schema": {
-                            "$ref": "#/definitions/usecase.GetTalentOutputDTO"
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "type": "string"
                         }
                     },
                     "404": {
-                        "description": "talent not found",
+                        "description": "delivery not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
                         "schema": {
                             "type": "string"
                         }
@@ -111,62 +226,113 @@ const docTemplate = `{
                 }
             }
         },
-        "/talents": {
-            "get": {
-                "description": "Retorna uma lista de talentos com paginação e filtros em memória.",
+        "/admin/webhooks/{id}": {
+            "put": {
+                "description": "Substitui URL, eventos e status de um webhook. O segredo é mantido.",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "talents"
+                    "webhooks"
                 ],
-                "summary": "Lista talentos",
+                "summary": "Atualiza um webhook",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Limite de registros por página",
-                        "name": "limit",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Cursor para próxima página",
-                        "name": "cursor",
-                        "in": "query"
+                        "description": "ID do webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Filtro por nome (substring, case-insensitive)",
-                        "name": "name",
-                        "in": "query"
+                        "description": "Dados do webhook",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.UpdateWebhookInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "updated",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "description": "Filtro por possible role (substring, case-insensitive)",
-                        "name": "possible_role",
-                        "in": "query"
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
-                    {
-                        "type": "array",
-                        "items": {
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
                             "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Tags (AND) - múltiplos valores ex: ?tags=go\u0026tags=backend",
-                        "name": "tags",
-                        "in": "query"
+                        }
+                    },
+                    "404": {
+                        "description": "webhook not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove um webhook. Entregas pendentes dele vão para a lista de dead letter.",
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Remove um webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do webhook",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
+                    "204": {
+                        "description": "deleted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "401": {
                         "description": "unauthorized",
                         "schema": {
                             "type": "string"
                         }
                     },
+                    "404": {
+                        "description": "webhook not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "internal error",
                         "schema": {
@@ -175,50 +341,3387 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "usecase.CreateTalentInputDTO": {
-            "type": "object",
-            "properties": {
-                "current_company": {
-                    "type": "string"
-                },
-                "current_role": {
-                    "type": "string"
-                },
-                "full_name": {
-                    "type": "string"
-                },
-                "headline": {
-                    "type": "string"
-                },
-                "notes": {
-                    "type": "string"
-                },
-                "possible_role": {
-                    "type": "string"
-                },
-                "profile_url": {
-                    "type": "string"
-                },
-                "tags": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
+        },
+        "/healthz": {
+            "get": {
+                "description": "Indica que o processo está no ar. Não depende do Firestore e não exige autenticação.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Liveness",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webserver.HealthResponse"
+                        }
                     }
                 }
             }
         },
-        "usecase.GetTalentOutputDTO": {
-            "type": "object",
-            "properties": {
-                "captured_at": {
-                    "type": "string"
-                },
-                "current_company": {
-                    "type": "string"
-                },
+        "/readyz": {
+            "get": {
+                "description": "Verifica se o banco de dados está acessível. Não exige autenticação.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Readiness",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webserver.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/webserver.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/searches": {
+            "get": {
+                "description": "Retorna as buscas do responsável e as compartilhadas, ordenadas pelo nome. Sem owner, apenas as compartilhadas.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "searches"
+                ],
+                "summary": "Lista buscas salvas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Responsável. É só um rótulo informado pelo cliente, sem autenticação",
+                        "name": "owner",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListSavedSearchesOutputDTO"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Guarda os filtros da listagem de talentos com um nome para executá-los novamente. Buscas compartilhadas aparecem para todos, as demais só para o owner, que é apenas um rótulo informado pelo cliente e não uma identidade autenticada. Filtros de pretensão salarial exigem token de recrutador ou admin.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "searches"
+                ],
+                "summary": "Salva uma busca de talentos",
+                "parameters": [
+                    {
+                        "description": "Dados da busca",
+                        "name": "search",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateSavedSearchInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.SavedSearchOutputDTO"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL para executar a busca"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "compensation filters need a recruiter or admin token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/searches/{id}": {
+            "delete": {
+                "tags": [
+                    "searches"
+                ],
+                "summary": "Remove uma busca salva",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da busca",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Responsável. É só um rótulo informado pelo cliente, sem autenticação",
+                        "name": "owner",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "saved search not found or private to another owner",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/searches/{id}/talents": {
+            "get": {
+                "description": "Retorna os talentos que atendem aos filtros da busca, com a mesma paginação de GET /talents.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "searches"
+                ],
+                "summary": "Executa uma busca salva",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da busca",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Responsável. É só um rótulo informado pelo cliente, sem autenticação",
+                        "name": "owner",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite de registros por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor para próxima página",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordenação, como em GET /talents",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListTalentsOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "compensation filters need a recruiter or admin token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "saved search not found or private to another owner",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/shortlists": {
+            "get": {
+                "description": "Retorna as shortlists do responsável e as compartilhadas, ordenadas pelo nome. Sem owner, apenas as compartilhadas.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shortlists"
+                ],
+                "summary": "Lista shortlists",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Responsável. É só um rótulo informado pelo cliente, sem autenticação",
+                        "name": "owner",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListShortlistsOutputDTO"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cria uma lista manual e ordenada de talentos, de até 200 talentos. Shortlists compartilhadas aparecem para todos, as demais só para o owner, que é apenas um rótulo informado pelo cliente e não uma identidade autenticada.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shortlists"
+                ],
+                "summary": "Cria uma shortlist",
+                "parameters": [
+                    {
+                        "description": "Dados da shortlist",
+                        "name": "shortlist",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateShortlistInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ShortlistOutputDTO"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL da shortlist recém-criada"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/shortlists/{id}": {
+            "get": {
+                "description": "Retorna a shortlist com os talentos na ordem da lista. Talentos removidos da base ficam apenas em talent_ids.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shortlists"
+                ],
+                "summary": "Busca uma shortlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da shortlist",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Responsável. É só um rótulo informado pelo cliente, sem autenticação",
+                        "name": "owner",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetShortlistOutputDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "shortlist not found or private to another owner",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "tags": [
+                    "shortlists"
+                ],
+                "summary": "Remove uma shortlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da shortlist",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Responsável. É só um rótulo informado pelo cliente, sem autenticação",
+                        "name": "owner",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "shortlist not found or private to another owner",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/shortlists/{id}/talents": {
+            "put": {
+                "description": "Recebe todos os talentos da shortlist na nova ordem.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shortlists"
+                ],
+                "summary": "Reordena uma shortlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da shortlist",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Responsável. É só um rótulo informado pelo cliente, sem autenticação",
+                        "name": "owner",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Talentos na nova ordem",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ReorderShortlistInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ShortlistOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "shortlist not found or private to another owner",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "shortlist changed concurrently",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Insere o talento na posição informada (a partir de zero) ou no fim da lista. Talentos que já estão na lista permanecem onde estão.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shortlists"
+                ],
+                "summary": "Adiciona um talento a uma shortlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da shortlist",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Responsável. É só um rótulo informado pelo cliente, sem autenticação",
+                        "name": "owner",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Talento e posição",
+                        "name": "talent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.AddShortlistTalentInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ShortlistOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "shortlist not found or private to another owner, or talent not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "shortlist changed concurrently",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/shortlists/{id}/talents/{talentId}": {
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shortlists"
+                ],
+                "summary": "Remove um talento de uma shortlist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da shortlist",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Responsável. É só um rótulo informado pelo cliente, sem autenticação",
+                        "name": "owner",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "talentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ShortlistOutputDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "shortlist not found or private to another owner",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "shortlist changed concurrently",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats": {
+            "get": {
+                "description": "Retorna talentos capturados por dia, semana ou mês, as tags, empresas e cargos mais frequentes e o funil por etapa. Talentos arquivados entram em todas as contagens, na etapa em que pararam. Sem from/to, considera os últimos 12 períodos.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Estatísticas do banco de talentos",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Agrupamento: day, week ou month (padrão week)",
+                        "name": "bucket",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Início do período, inclusivo (RFC 3339 ou AAAA-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fim do período, exclusivo (RFC 3339 ou AAAA-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Quantidade de itens nos rankings (padrão 10, máximo 50)",
+                        "name": "top",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetStatsOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "description": "Retorna as tags mais usadas cujo nome começa com q, para autocomplete. Hífens, espaços e maiúsculas são ignorados na comparação.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Lista tags do catálogo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Início do nome da tag",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Categoria: language, framework, domain ou seniority",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite de registros (padrão 20, máximo 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListTagsOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Cadastra uma tag canônica com categoria e sinônimos. Talentos cadastrados com um sinônimo passam a usar o nome canônico.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Cadastra uma tag",
+                "parameters": [
+                    {
+                        "description": "Nome, categoria (language, framework, domain ou seniority) e sinônimos",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateTagInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.TagOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "name or alias already used by another tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/suggest": {
+            "post": {
+                "description": "Retorna as tags do catálogo encontradas no headline e nos cargos, em português ou inglês, sem salvar nada. São as mesmas usadas ao cadastrar um talento sem tags.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Sugere tags para um perfil",
+                "parameters": [
+                    {
+                        "description": "Headline e cargos",
+                        "name": "profile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.SuggestTagsInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.SuggestTagsOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}": {
+            "put": {
+                "description": "Substitui nome, categoria e sinônimos. O nome anterior passa a ser um sinônimo.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Altera uma tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da tag",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Nome, categoria e sinônimos",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.UpdateTagInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.TagOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "tag not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "name or alias already used by another tag",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}/merge": {
+            "post": {
+                "description": "O nome e os sinônimos da tag passam a ser sinônimos da tag de destino, os talentos que a usam são reescritos em lotes e a tag é removida. Pode ser repetido se for interrompido.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Mescla uma tag em outra",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da tag a ser mesclada",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ID da tag de destino",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.MergeTagInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.MergeTagOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "tag not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/talent": {
+            "post": {
+                "description": "Cadastra um talento com os dados enviados no corpo da requisição. Sem tags, aplica as sugeridas a partir do headline e dos cargos e as devolve em suggested_tags.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "talents"
+                ],
+                "summary": "Cria um talento",
+                "parameters": [
+                    {
+                        "description": "Dados do talento",
+                        "name": "talent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateTalentInputDTO"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Chave para repetir a requisição sem duplicar o talento",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Recurso criado",
+                        "schema": {
+                            "$ref": "#/definitions/webserver.CreateTalentResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL do talento recém-criado"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "compensation needs a recruiter or admin token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "email used by another talent or request with the same idempotency key in progress",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "idempotency key reused with a different body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/talent/{id}": {
+            "get": {
+                "description": "Retorna os dados completos de um talento específico. A versão atual é enviada no header ETag. A pretensão salarial só aparece para tokens de recrutador ou admin.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "talents"
+                ],
+                "summary": "Busca um talento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag já conhecido pelo cliente",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.GetTalentOutputDTO"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Versão atual do talento"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "not modified",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "talent not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Substitui os dados de um talento. Exige o header If-Match com o ETag obtido na leitura.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "talents"
+                ],
+                "summary": "Atualiza um talento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag da versão que está sendo alterada",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados do talento",
+                        "name": "talent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.UpdateTalentInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "updated",
+                        "schema": {
+                            "type": "string"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Nova versão do talento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "compensation needs a recruiter or admin token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "talent not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "email used by another talent",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header required",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove um talento. Exige o header If-Match com o ETag obtido na leitura.",
+                "tags": [
+                    "talents"
+                ],
+                "summary": "Remove um talento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag da versão que está sendo removida",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "deleted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "talent not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header required",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Altera apenas os campos enviados. Exige o header If-Match com o ETag obtido na leitura.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "talents"
+                ],
+                "summary": "Altera parcialmente um talento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag da versão que está sendo alterada",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Campos a alterar",
+                        "name": "talent",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.PatchTalentInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "updated",
+                        "schema": {
+                            "type": "string"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Nova versão do talento"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "compensation needs a recruiter or admin token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "talent not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "email used by another talent or talent archived",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header required",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/talent/{id}/archive": {
+            "post": {
+                "description": "Arquiva um talento sem removê-lo. Talentos arquivados somem da listagem padrão. Exige o header If-Match com o ETag obtido na leitura.",
+                "tags": [
+                    "talents"
+                ],
+                "summary": "Arquiva um talento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag da versão que está sendo arquivada",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "archived",
+                        "schema": {
+                            "type": "string"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Nova versão do talento"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "talent not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "talent already archived",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "428": {
+                        "description": "If-Match header required",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/talent/{id}/attachments": {
+            "get": {
+                "description": "Retorna os metadados dos anexos, do mais recente para o mais antigo.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Lista os anexos de um talento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListAttachmentsOutputDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "talent not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Recebe um arquivo via multipart/form-data no campo file. O tipo é detectado pelo conteúdo (PDF, DOCX, texto, PNG ou JPEG) e arquivos iguais são armazenados uma única vez. O texto de PDF, DOCX e texto é extraído em segundo plano, acompanhe pelo campo text.status.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Anexa um arquivo a um talento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Arquivo",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tipo do anexo (cv, portfolio, other), padrão other",
+                        "name": "kind",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.AttachmentOutputDTO"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL para baixar o anexo"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "talent not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "file too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "415": {
+                        "description": "unsupported file type",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/talent/{id}/attachments/{attachmentId}": {
+            "get": {
+                "description": "Retorna o conteúdo do anexo com o tipo detectado no upload. O ETag é o SHA-256 do conteúdo.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Baixa um anexo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do anexo",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag já conhecido pelo cliente",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "conteúdo do anexo",
+                        "schema": {
+                            "type": "file"
+                        },
+                        "headers": {
+                            "Content-Disposition": {
+                                "type": "string",
+                                "description": "Nome original do arquivo"
+                            },
+                            "ETag": {
+                                "type": "string",
+                                "description": "SHA-256 do conteúdo"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "not modified",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "attachment not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/talent/{id}/attachments/{attachmentId}/text": {
+            "get": {
+                "description": "Disponível quando text.status do anexo é done. O texto alimenta a busca de talentos e as sugestões de tags.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "attachments"
+                ],
+                "summary": "Retorna o texto extraído de um anexo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do anexo",
+                        "name": "attachmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "texto extraído",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "attachment not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "text not available yet or extraction failed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/talent/{id}/scorecards": {
+            "get": {
+                "description": "Retorna os scorecards do mais antigo para o mais recente, com a média por critério e o número de avaliações.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scorecards"
+                ],
+                "summary": "Lista as avaliações de um talento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Apenas as avaliações da vaga",
+                        "name": "opening_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListScorecardsOutputDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "talent not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Registra um scorecard com notas de 1 a 5 por critério, recomendação (strong_no, no, yes, strong_yes) e comentário, opcionalmente para uma vaga. O resumo das avaliações aparece em GET /talent/{id} e permite ordenar GET /talents por rating.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scorecards"
+                ],
+                "summary": "Avalia um talento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Avaliação",
+                        "name": "scorecard",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateScorecardInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ScorecardOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "talent not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/talent/{id}/tasks": {
+            "post": {
+                "description": "Agenda uma tarefa de follow-up com responsável, data limite e observação.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Cria um follow-up para um talento",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dados da tarefa",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.CreateTaskInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.TaskOutputDTO"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL da tarefa recém-criada"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "talent not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/talents": {
+            "get": {
+                "description": "Retorna uma lista de talentos com paginação e filtros em memória.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "talents"
+                ],
+                "summary": "Lista talentos",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Limite de registros por página",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor para próxima página",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Busca por palavras (AND, prefixo) no nome, headline, cargos, empresa, notas, tags e no texto dos currículos anexados",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtro por nome (substring, case-insensitive)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filtro por possible role (substring, case-insensitive)",
+                        "name": "possible_role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Tags (AND) - múltiplos valores ex: ?tags=go\u0026tags=backend",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Etapa do funil (sourced, contacted, screening, interviewing, offer, hired, rejected)",
+                        "name": "stage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Inclui talentos arquivados",
+                        "name": "archived",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Área normalizada do cargo (backend, frontend, fullstack, mobile, data, devops, qa, security, design, product, software)",
+                        "name": "function",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Senioridade normalizada (junior, mid, senior, staff, lead)",
+                        "name": "seniority",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Busca por email, telefone, usuário do GitHub ou URL de perfil (ignora o cursor)",
+                        "name": "contact",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Países (ISO 3166-1 alpha-2, OR) - múltiplos valores ex: ?country=BR\u0026country=PT",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Estado",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cidade (ignora acentos e maiúsculas)",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fuso horário IANA, ex: America/Sao_Paulo",
+                        "name": "timezone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Modelo de trabalho (remote, hybrid, onsite)",
+                        "name": "work_model",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Apenas talentos dispostos a se mudar",
+                        "name": "relocation",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Moeda da pretensão salarial (ISO 4217), obrigatória com min ou max. Exige token de recrutador ou admin",
+                        "name": "compensation_currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Período de min e max (monthly, yearly), padrão monthly",
+                        "name": "compensation_period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Pretensão mínima em centavos",
+                        "name": "compensation_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Pretensão máxima em centavos",
+                        "name": "compensation_max",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tipo de contrato (clt, pj, contractor)",
+                        "name": "contract",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ordenação por até 2 chaves separadas por vírgula (captured_at, updated_at, full_name, current_company, rating), com - para decrescente, ex: full_name,-captured_at. Padrão -captured_at. Textos são comparados byte a byte (maiúsculas primeiro). Talentos sem o campo ordenado ficam de fora: os cadastrados antes de updated_at ou de rating existirem só aparecem após o backfill (go run ./cmd/backfill). O cursor só vale para a mesma ordenação",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListTalentsOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "compensation filters need a recruiter or admin token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks": {
+            "get": {
+                "description": "Retorna as tarefas de follow-up ordenadas pela data limite.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Lista follow-ups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Responsável",
+                        "name": "assignee",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Data limite máxima (RFC 3339 ou AAAA-MM-DD)",
+                        "name": "due_before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID do talento",
+                        "name": "talent_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filtra tarefas concluídas ou abertas",
+                        "name": "done",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limite de registros (padrão 50, máximo 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.ListTasksOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}": {
+            "patch": {
+                "description": "Altera apenas os campos enviados. Use done=true para concluir a tarefa.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Altera um follow-up",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID da tarefa",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Campos a alterar",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/usecase.UpdateTaskInputDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecase.TaskOutputDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "task not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "request body too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "too many requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "internal error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Retorna o commit, a data do build e a versão do Go. Não exige autenticação.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Versão",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/buildinfo.Info"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "buildinfo.Info": {
+            "type": "object",
+            "properties": {
+                "build_time": {
+                    "type": "string"
+                },
+                "commit": {
+                    "type": "string"
+                },
+                "go_version": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.AddShortlistTalentInputDTO": {
+            "type": "object",
+            "properties": {
+                "position": {
+                    "description": "Position is zero based, the talent goes to the end when it is missing.",
+                    "type": "integer"
+                },
+                "talent_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.AttachmentOutputDTO": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "file_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "sha256": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "talent_id": {
+                    "type": "string"
+                },
+                "text": {
+                    "$ref": "#/definitions/usecase.AttachmentTextDTO"
+                },
+                "uploaded_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.AttachmentTextDTO": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "extracted_at": {
+                    "type": "string"
+                },
+                "length": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "suggested_tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "usecase.CompensationDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "contract": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CompensationFilterDTO": {
+            "type": "object",
+            "properties": {
+                "contract": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "max": {
+                    "type": "integer"
+                },
+                "min": {
+                    "type": "integer"
+                },
+                "period": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.ContactsDTO": {
+            "type": "object",
+            "properties": {
+                "emails": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "github": {
+                    "type": "string"
+                },
+                "phones": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "socials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.SocialProfileDTO"
+                    }
+                },
+                "website": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CreateSavedSearchInputDTO": {
+            "type": "object",
+            "properties": {
+                "filter": {
+                    "$ref": "#/definitions/usecase.TalentFilterDTO"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "shared": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "usecase.CreateScorecardInputDTO": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "criteria": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.CriterionScoreDTO"
+                    }
+                },
+                "evaluator": {
+                    "type": "string"
+                },
+                "opening_id": {
+                    "type": "string"
+                },
+                "recommendation": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CreateShortlistInputDTO": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "shared": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "usecase.CreateTagInputDTO": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "category": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CreateTalentInputDTO": {
+            "type": "object",
+            "properties": {
+                "compensation": {
+                    "$ref": "#/definitions/usecase.CompensationDTO"
+                },
+                "contacts": {
+                    "$ref": "#/definitions/usecase.ContactsDTO"
+                },
+                "current_company": {
+                    "type": "string"
+                },
+                "current_role": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "headline": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/usecase.LocationDTO"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "possible_role": {
+                    "type": "string"
+                },
+                "profile_url": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "usecase.CreateTaskInputDTO": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CreateWebhookInputDTO": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CreateWebhookOutputDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.CriterionScoreDTO": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.CriterionSummaryDTO": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.GetShortlistOutputDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "shared": {
+                    "type": "boolean"
+                },
+                "talent_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "talents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.TalentDTO"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.GetStatsOutputDTO": {
+            "type": "object",
+            "properties": {
+                "bucket": {
+                    "type": "string"
+                },
+                "captured": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.StatsBucketDTO"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "funnel": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.StatsCountDTO"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                },
+                "top_companies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.StatsCountDTO"
+                    }
+                },
+                "top_roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.StatsCountDTO"
+                    }
+                },
+                "top_tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.StatsCountDTO"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.GetTalentOutputDTO": {
+            "type": "object",
+            "properties": {
+                "archived_at": {
+                    "type": "string"
+                },
+                "captured_at": {
+                    "type": "string"
+                },
+                "compensation": {
+                    "$ref": "#/definitions/usecase.CompensationDTO"
+                },
+                "contacts": {
+                    "$ref": "#/definitions/usecase.ContactsDTO"
+                },
+                "current_company": {
+                    "type": "string"
+                },
+                "current_role": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "headline": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/usecase.LocationDTO"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "possible_role": {
+                    "type": "string"
+                },
+                "profile_url": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/usecase.RoleProfileDTO"
+                },
+                "scorecards": {
+                    "$ref": "#/definitions/usecase.ScorecardsDTO"
+                },
+                "stage": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.ListAttachmentsOutputDTO": {
+            "type": "object",
+            "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.AttachmentOutputDTO"
+                    }
+                }
+            }
+        },
+        "usecase.ListSavedSearchesOutputDTO": {
+            "type": "object",
+            "properties": {
+                "searches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.SavedSearchOutputDTO"
+                    }
+                }
+            }
+        },
+        "usecase.ListScorecardsOutputDTO": {
+            "type": "object",
+            "properties": {
+                "scorecards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.ScorecardOutputDTO"
+                    }
+                },
+                "summary": {
+                    "$ref": "#/definitions/usecase.ScorecardsDTO"
+                }
+            }
+        },
+        "usecase.ListShortlistsOutputDTO": {
+            "type": "object",
+            "properties": {
+                "shortlists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.ShortlistOutputDTO"
+                    }
+                }
+            }
+        },
+        "usecase.ListTagsOutputDTO": {
+            "type": "object",
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.TagOutputDTO"
+                    }
+                }
+            }
+        },
+        "usecase.ListTalentsOutputDTO": {
+            "type": "object",
+            "properties": {
+                "next_cursor": {
+                    "type": "string"
+                },
+                "talents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.TalentDTO"
+                    }
+                }
+            }
+        },
+        "usecase.ListTasksOutputDTO": {
+            "type": "object",
+            "properties": {
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.TaskOutputDTO"
+                    }
+                }
+            }
+        },
+        "usecase.ListWebhookDeliveriesOutputDTO": {
+            "type": "object",
+            "properties": {
+                "deliveries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.WebhookDeliveryOutputDTO"
+                    }
+                }
+            }
+        },
+        "usecase.ListWebhooksOutputDTO": {
+            "type": "object",
+            "properties": {
+                "webhooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.WebhookOutputDTO"
+                    }
+                }
+            }
+        },
+        "usecase.LocationDTO": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "open_to_relocation": {
+                    "type": "boolean"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "work_model": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.LocationFilterDTO": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "open_to_relocation": {
+                    "type": "boolean"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "work_model": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.MergeTagInputDTO": {
+            "type": "object",
+            "properties": {
+                "into": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.MergeTagOutputDTO": {
+            "type": "object",
+            "properties": {
+                "tag": {
+                    "$ref": "#/definitions/usecase.TagOutputDTO"
+                },
+                "talents_updated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.PatchTalentInputDTO": {
+            "type": "object",
+            "properties": {
+                "compensation": {
+                    "description": "Compensation clears the expectation when sent as an empty object.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/usecase.CompensationDTO"
+                        }
+                    ]
+                },
+                "contacts": {
+                    "$ref": "#/definitions/usecase.ContactsDTO"
+                },
+                "current_company": {
+                    "type": "string"
+                },
+                "current_role": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "headline": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/usecase.LocationDTO"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "possible_role": {
+                    "type": "string"
+                },
+                "profile_url": {
+                    "type": "string"
+                },
+                "stage": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "usecase.ReorderShortlistInputDTO": {
+            "type": "object",
+            "properties": {
+                "talent_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "usecase.RoleProfileDTO": {
+            "type": "object",
+            "properties": {
+                "function": {
+                    "type": "string"
+                },
+                "management": {
+                    "type": "boolean"
+                },
+                "seniority": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.SavedSearchOutputDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "filter": {
+                    "$ref": "#/definitions/usecase.TalentFilterDTO"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "shared": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "usecase.ScorecardOutputDTO": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "criteria": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.CriterionScoreDTO"
+                    }
+                },
+                "evaluator": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "opening_id": {
+                    "type": "string"
+                },
+                "recommendation": {
+                    "type": "string"
+                },
+                "talent_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.ScorecardsDTO": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "criteria": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecase.CriterionSummaryDTO"
+                    }
+                },
+                "recommendations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "usecase.ShortlistOutputDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "shared": {
+                    "type": "boolean"
+                },
+                "talent_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.SocialProfileDTO": {
+            "type": "object",
+            "properties": {
+                "network": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.StatsBucketDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.StatsCountDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.SuggestTagsInputDTO": {
+            "type": "object",
+            "properties": {
+                "current_role": {
+                    "type": "string"
+                },
+                "headline": {
+                    "type": "string"
+                },
+                "possible_role": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.SuggestTagsOutputDTO": {
+            "type": "object",
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "usecase.TagOutputDTO": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "category": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "usage_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "usecase.TalentDTO": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "captured_at": {
+                    "type": "string"
+                },
+                "compensation": {
+                    "$ref": "#/definitions/usecase.CompensationDTO"
+                },
+                "contacts": {
+                    "$ref": "#/definitions/usecase.ContactsDTO"
+                },
+                "current_company": {
+                    "type": "string"
+                },
                 "current_role": {
                     "type": "string"
                 },
@@ -231,6 +3734,151 @@ const docTemplate = `{
                 "id": {
                     "type": "string"
                 },
+                "location": {
+                    "$ref": "#/definitions/usecase.LocationDTO"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "possible_role": {
+                    "type": "string"
+                },
+                "profile_url": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/usecase.RoleProfileDTO"
+                },
+                "scorecards": {
+                    "$ref": "#/definitions/usecase.ScorecardsDTO"
+                },
+                "stage": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "usecase.TalentFilterDTO": {
+            "type": "object",
+            "properties": {
+                "compensation": {
+                    "$ref": "#/definitions/usecase.CompensationFilterDTO"
+                },
+                "function": {
+                    "type": "string"
+                },
+                "include_archived": {
+                    "type": "boolean"
+                },
+                "location": {
+                    "$ref": "#/definitions/usecase.LocationFilterDTO"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "possible_role": {
+                    "type": "string"
+                },
+                "q": {
+                    "type": "string"
+                },
+                "seniority": {
+                    "type": "string"
+                },
+                "stage": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "usecase.TaskOutputDTO": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "type": "string"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "done": {
+                    "type": "boolean"
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "overdue": {
+                    "type": "boolean"
+                },
+                "talent_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.UpdateTagInputDTO": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "category": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.UpdateTalentInputDTO": {
+            "type": "object",
+            "properties": {
+                "compensation": {
+                    "description": "Compensation is kept as is when the caller has no access to it.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/usecase.CompensationDTO"
+                        }
+                    ]
+                },
+                "contacts": {
+                    "$ref": "#/definitions/usecase.ContactsDTO"
+                },
+                "current_company": {
+                    "type": "string"
+                },
+                "current_role": {
+                    "type": "string"
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "headline": {
+                    "type": "string"
+                },
+                "location": {
+                    "$ref": "#/definitions/usecase.LocationDTO"
+                },
                 "notes": {
                     "type": "string"
                 },
@@ -248,13 +3896,122 @@ const docTemplate = `{
                 }
             }
         },
+        "usecase.UpdateTaskInputDTO": {
+            "type": "object",
+            "properties": {
+                "assignee": {
+                    "type": "string"
+                },
+                "done": {
+                    "type": "boolean"
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.UpdateWebhookInputDTO": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.WebhookDeliveryOutputDTO": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecase.WebhookOutputDTO": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "webserver.CreateTalentResponse": {
             "type": "object",
             "properties": {
+                "suggested_tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "value": {
                     "type": "string"
                 }
             }
+        },
+        "webserver.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
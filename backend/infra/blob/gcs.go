@@ -0,0 +1,58 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"cloud.google.com/go/storage"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"google.golang.org/api/googleapi"
+)
+
+// GCSStore keeps blobs as objects of a Cloud Storage bucket.
+type GCSStore struct {
+	bucket *storage.BucketHandle
+}
+
+func NewGCSStore(client *storage.Client, bucket string) *GCSStore {
+	return &GCSStore{
+		bucket: client.Bucket(bucket),
+	}
+}
+
+// Put only creates the object when it does not exist yet. Keys are content
+// addressed, so an existing object already has the same content.
+func (s *GCSStore) Put(ctx context.Context, key string, content io.Reader, contentType string) error {
+	writer := s.bucket.Object(key).If(storage.Conditions{DoesNotExist: true}).NewWriter(ctx)
+	writer.ContentType = contentType
+
+	_, err := io.Copy(writer, content)
+	if err != nil {
+		writer.Close()
+		return err
+	}
+	err = writer.Close()
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusPreconditionFailed {
+		return nil
+	}
+	return err
+}
+
+func (s *GCSStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	reader, err := s.bucket.Object(key).NewReader(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, domain.ErrBlobNotFound
+	}
+	return reader, err
+}
+
+func (s *GCSStore) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := s.bucket.Object(key).Attrs(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,64 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewLocalStore(filepath.Join(dir, "attachments"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if exists, err := store.Exists(ctx, "sha256/abc"); exists || err != nil {
+		t.Fatalf("expected a missing blob, got %v, %v", exists, err)
+	}
+	if _, err = store.Get(ctx, "sha256/abc"); !errors.Is(err, domain.ErrBlobNotFound) {
+		t.Fatalf("expected ErrBlobNotFound, got %v", err)
+	}
+
+	for _, content := range []string{"first", "second"} {
+		err = store.Put(ctx, "sha256/abc", strings.NewReader(content), "text/plain")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if exists, _ := store.Exists(ctx, "sha256/abc"); !exists {
+		t.Error("expected the blob to exist")
+	}
+	reader, err := store.Get(ctx, "sha256/abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer reader.Close()
+	if content, _ := io.ReadAll(reader); string(content) != "second" {
+		t.Errorf("expected the last write, got %q", content)
+	}
+
+	entries, _ := os.ReadDir(filepath.Join(dir, "attachments", "sha256"))
+	if len(entries) != 1 {
+		t.Errorf("expected no temporary files left behind, got %d entries", len(entries))
+	}
+}
+
+func TestLocalStoreRejectsKeysOutsideTheDirectory(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, key := range []string{"../escape", "/etc/passwd", ""} {
+		if err = store.Put(context.Background(), key, strings.NewReader("x"), "text/plain"); err == nil {
+			t.Errorf("expected %q to be rejected", key)
+		}
+	}
+}
@@ -0,0 +1,505 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+type Config struct {
+	Server      ServerConfig      `yaml:"server"`
+	Log         LogConfig         `yaml:"log"`
+	Tracing     TracingConfig     `yaml:"tracing"`
+	RateLimit   RateLimitConfig   `yaml:"rate_limit"`
+	CORS        CORSConfig        `yaml:"cors"`
+	Events      EventsConfig      `yaml:"events"`
+	Webhook     WebhookConfig     `yaml:"webhook"`
+	Tasks       TasksConfig       `yaml:"tasks"`
+	Tags        TagsConfig        `yaml:"tags"`
+	Attachments AttachmentsConfig `yaml:"attachments"`
+	Firestore   FirestoreConfig   `yaml:"firestore"`
+	Idempotency IdempotencyConfig `yaml:"idempotency"`
+}
+
+type ServerConfig struct {
+	Port     int    `yaml:"port"`
+	APIToken string `yaml:"api_token"`
+	// AdminToken protects the /admin routes and is also accepted on the API
+	// routes with access to compensation. The /admin routes are disabled
+	// when empty.
+	AdminToken string `yaml:"admin_token"`
+	// RecruiterToken is accepted on the API routes like APIToken and also
+	// gives access to compensation. Disabled when empty.
+	RecruiterToken    string        `yaml:"recruiter_token"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout"`
+	ReadinessTimeout  time.Duration `yaml:"readiness_timeout"`
+	MaxBodyBytes      int64         `yaml:"max_body_bytes"`
+}
+
+type LogConfig struct {
+	// Level is one of debug, info, warn or error.
+	Level string `yaml:"level"`
+	// Format is json (Cloud Logging) or text.
+	Format string `yaml:"format"`
+}
+
+// RateLimitConfig holds token bucket budgets. Read budgets apply to GET
+// routes and write budgets to everything else.
+type RateLimitConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// TrustProxy makes the client IP come from X-Forwarded-For.
+	TrustProxy bool       `yaml:"trust_proxy"`
+	KeyRead    RateBudget `yaml:"key_read"`
+	KeyWrite   RateBudget `yaml:"key_write"`
+	IPRead     RateBudget `yaml:"ip_read"`
+	IPWrite    RateBudget `yaml:"ip_write"`
+}
+
+type RateBudget struct {
+	RequestsPerMinute int `yaml:"requests_per_minute"`
+	Burst             int `yaml:"burst"`
+}
+
+type CORSConfig struct {
+	// AllowedOrigins accepts exact origins such as
+	// chrome-extension://<extension id> or https://app.example.com, or "*".
+	AllowedOrigins   []string      `yaml:"allowed_origins"`
+	AllowedMethods   []string      `yaml:"allowed_methods"`
+	AllowedHeaders   []string      `yaml:"allowed_headers"`
+	ExposedHeaders   []string      `yaml:"exposed_headers"`
+	AllowCredentials bool          `yaml:"allow_credentials"`
+	MaxAge           time.Duration `yaml:"max_age"`
+}
+
+// EventsConfig tunes the in-process event bus and the outbox relay that
+// feeds it.
+type EventsConfig struct {
+	Workers            int           `yaml:"workers"`
+	QueueSize          int           `yaml:"queue_size"`
+	OutboxPollInterval time.Duration `yaml:"outbox_poll_interval"`
+	OutboxBatchSize    int           `yaml:"outbox_batch_size"`
+}
+
+type WebhookConfig struct {
+	Workers        int           `yaml:"workers"`
+	QueueSize      int           `yaml:"queue_size"`
+	MaxAttempts    int           `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+	Timeout        time.Duration `yaml:"timeout"`
+	// RescanInterval is how often deliveries left pending by a restart or a
+	// full queue are queued again.
+	RescanInterval time.Duration `yaml:"rescan_interval"`
+}
+
+// TasksConfig drives the follow-up scheduler. The daily digest goes out on
+// the first scan after DigestHourUTC.
+type TasksConfig struct {
+	ScanInterval  time.Duration `yaml:"scan_interval"`
+	DigestHourUTC int           `yaml:"digest_hour_utc"`
+}
+
+type TracingConfig struct {
+	// Exporter is none, stdout (local debugging) or otlp.
+	Exporter    string  `yaml:"exporter"`
+	Endpoint    string  `yaml:"endpoint"`
+	Insecure    bool    `yaml:"insecure"`
+	SampleRatio float64 `yaml:"sample_ratio"`
+	ServiceName string  `yaml:"service_name"`
+}
+
+type FirestoreConfig struct {
+	// ProjectID may be left empty to let the client detect it from the
+	// environment credentials.
+	ProjectID string `yaml:"project_id"`
+}
+
+// TagsConfig sets how long tag suggestions may take to reflect catalog
+// changes.
+type TagsConfig struct {
+	SuggestionCacheTTL time.Duration `yaml:"suggestion_cache_ttl"`
+}
+
+// AttachmentsConfig chooses where attachment contents are kept: a local
+// directory for development or a Cloud Storage bucket.
+type AttachmentsConfig struct {
+	// Store is local or gcs.
+	Store    string `yaml:"store"`
+	LocalDir string `yaml:"local_dir"`
+	Bucket   string `yaml:"bucket"`
+	MaxBytes int64  `yaml:"max_bytes"`
+	// ExtractionWorkers read the text of PDF, DOCX and text uploads in the
+	// background.
+	ExtractionWorkers   int `yaml:"extraction_workers"`
+	ExtractionQueueSize int `yaml:"extraction_queue_size"`
+	// ExtractionRescanInterval is how often uploads left pending by a
+	// restart or a full queue are queued again.
+	ExtractionRescanInterval time.Duration `yaml:"extraction_rescan_interval"`
+}
+
+type IdempotencyConfig struct {
+	TTL time.Duration `yaml:"ttl"`
+}
+
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Port:              8080,
+			ReadHeaderTimeout: 5 * time.Second,
+			ReadTimeout:       15 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       60 * time.Second,
+			// Cloud Run waits 10s after SIGTERM before killing the container
+			ShutdownTimeout:  9 * time.Second,
+			ReadinessTimeout: 2 * time.Second,
+			MaxBodyBytes:     1 << 20,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:    true,
+			TrustProxy: true,
+			KeyRead:    RateBudget{RequestsPerMinute: 600, Burst: 60},
+			KeyWrite:   RateBudget{RequestsPerMinute: 60, Burst: 20},
+			IPRead:     RateBudget{RequestsPerMinute: 300, Burst: 30},
+			IPWrite:    RateBudget{RequestsPerMinute: 30, Burst: 10},
+		},
+		CORS: CORSConfig{
+			AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+			AllowedHeaders: []string{"Authorization", "Content-Type", "Idempotency-Key", "If-Match", "If-None-Match", "X-Request-ID"},
+			ExposedHeaders: []string{"Location", "ETag", "Retry-After", "X-Request-ID", "X-Trace-Id", "Idempotent-Replayed"},
+			MaxAge:         10 * time.Minute,
+		},
+		Events: EventsConfig{
+			Workers:            4,
+			QueueSize:          1000,
+			OutboxPollInterval: 10 * time.Second,
+			OutboxBatchSize:    100,
+		},
+		Webhook: WebhookConfig{
+			Workers:        2,
+			QueueSize:      500,
+			MaxAttempts:    6,
+			InitialBackoff: 5 * time.Second,
+			MaxBackoff:     10 * time.Minute,
+			Timeout:        10 * time.Second,
+			RescanInterval: time.Minute,
+		},
+		Tasks: TasksConfig{
+			ScanInterval:  time.Minute,
+			DigestHourUTC: 11,
+		},
+		Tags: TagsConfig{
+			SuggestionCacheTTL: 5 * time.Minute,
+		},
+		Attachments: AttachmentsConfig{
+			Store:    "local",
+			LocalDir: "attachments",
+			MaxBytes: 10 << 20,
+			// parsing is CPU bound, Cloud Run instances have one or two
+			ExtractionWorkers:        1,
+			ExtractionQueueSize:      100,
+			ExtractionRescanInterval: 5 * time.Minute,
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			SampleRatio: 1,
+			ServiceName: "talent-db",
+		},
+		Idempotency: IdempotencyConfig{
+			TTL: 24 * time.Hour,
+		},
+	}
+}
+
+// Load builds the configuration from defaults, an optional YAML file, the
+// environment and command line flags, each one overriding the previous.
+func Load(args []string) (*Config, error) {
+	return load(args, os.LookupEnv)
+}
+
+func load(args []string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	cfg := Default()
+
+	flags := flag.NewFlagSet("talent-db", flag.ContinueOnError)
+	configFile := flags.String("config", "", "path to a YAML configuration file")
+	port := flags.Int("port", 0, "HTTP port")
+	projectID := flags.String("firestore-project", "", "Firestore project id")
+	shutdownTimeout := flags.Duration("shutdown-timeout", 0, "how long to wait for in-flight requests on shutdown")
+	idempotencyTTL := flags.Duration("idempotency-ttl", 0, "how long Idempotency-Key responses are kept")
+	err := flags.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	path := *configFile
+	if path == "" {
+		path, _ = lookupEnv("CONFIG_FILE")
+	}
+	if path != "" {
+		err = loadFile(path, &cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	envErr := loadEnv(lookupEnv, &cfg)
+
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "port":
+			cfg.Server.Port = *port
+		case "shutdown-timeout":
+			cfg.Server.ShutdownTimeout = *shutdownTimeout
+		case "firestore-project":
+			cfg.Firestore.ProjectID = *projectID
+		case "idempotency-ttl":
+			cfg.Idempotency.TTL = *idempotencyTTL
+		}
+	})
+
+	err = errors.Join(envErr, cfg.Validate())
+	if err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+	err = yaml.UnmarshalStrict(data, cfg)
+	if err != nil {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+	return nil
+}
+
+func loadEnv(lookupEnv func(string) (string, bool), cfg *Config) error {
+	var errs []error
+
+	// SERVER_PORT is kept for existing deployments, PORT is what Cloud Run sets
+	for _, name := range []string{"SERVER_PORT", "PORT"} {
+		if value, ok := lookupEnv(name); ok && value != "" {
+			port, err := strconv.Atoi(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s must be a number, got %q", name, value))
+				continue
+			}
+			cfg.Server.Port = port
+		}
+	}
+	if value, ok := lookupEnv("API_TOKEN"); ok && value != "" {
+		cfg.Server.APIToken = value
+	}
+	if value, ok := lookupEnv("SHUTDOWN_TIMEOUT"); ok && value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("SHUTDOWN_TIMEOUT must be a duration like 10s, got %q", value))
+		} else {
+			cfg.Server.ShutdownTimeout = timeout
+		}
+	}
+	if value, ok := lookupEnv("LOG_LEVEL"); ok && value != "" {
+		cfg.Log.Level = value
+	}
+	if value, ok := lookupEnv("LOG_FORMAT"); ok && value != "" {
+		cfg.Log.Format = value
+	}
+	if value, ok := lookupEnv("RATE_LIMIT_ENABLED"); ok && value != "" {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("RATE_LIMIT_ENABLED must be true or false, got %q", value))
+		} else {
+			cfg.RateLimit.Enabled = enabled
+		}
+	}
+	if value, ok := lookupEnv("CORS_ALLOWED_ORIGINS"); ok && value != "" {
+		cfg.CORS.AllowedOrigins = nil
+		for _, origin := range strings.Split(value, ",") {
+			if origin = strings.TrimSpace(origin); origin != "" {
+				cfg.CORS.AllowedOrigins = append(cfg.CORS.AllowedOrigins, origin)
+			}
+		}
+	}
+	if value, ok := lookupEnv("TRACING_EXPORTER"); ok && value != "" {
+		cfg.Tracing.Exporter = value
+	}
+	if value, ok := lookupEnv("TRACING_ENDPOINT"); ok && value != "" {
+		cfg.Tracing.Endpoint = value
+	}
+	if value, ok := lookupEnv("TRACING_SAMPLE_RATIO"); ok && value != "" {
+		ratio, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("TRACING_SAMPLE_RATIO must be a number between 0 and 1, got %q", value))
+		} else {
+			cfg.Tracing.SampleRatio = ratio
+		}
+	}
+	if value, ok := lookupEnv("ADMIN_TOKEN"); ok && value != "" {
+		cfg.Server.AdminToken = value
+	}
+	if value, ok := lookupEnv("RECRUITER_TOKEN"); ok && value != "" {
+		cfg.Server.RecruiterToken = value
+	}
+	if value, ok := lookupEnv("ATTACHMENTS_STORE"); ok && value != "" {
+		cfg.Attachments.Store = value
+	}
+	if value, ok := lookupEnv("ATTACHMENTS_BUCKET"); ok && value != "" {
+		cfg.Attachments.Bucket = value
+	}
+	if value, ok := lookupEnv("FIRESTORE_PROJECT_ID"); ok && value != "" {
+		cfg.Firestore.ProjectID = value
+	}
+	if value, ok := lookupEnv("IDEMPOTENCY_TTL"); ok && value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("IDEMPOTENCY_TTL must be a duration like 24h, got %q", value))
+		} else {
+			cfg.Idempotency.TTL = ttl
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		errs = append(errs, fmt.Errorf("server.port must be between 1 and 65535, got %d", c.Server.Port))
+	}
+	if c.Server.APIToken == "" {
+		errs = append(errs, errors.New("server.api_token is required (set API_TOKEN)"))
+	}
+	if c.Server.AdminToken != "" && (c.Server.AdminToken == c.Server.APIToken || c.Server.AdminToken == c.Server.RecruiterToken) {
+		errs = append(errs, errors.New("server.admin_token must differ from server.api_token and server.recruiter_token"))
+	}
+	if c.Server.RecruiterToken != "" && c.Server.RecruiterToken == c.Server.APIToken {
+		errs = append(errs, errors.New("server.recruiter_token must differ from server.api_token"))
+	}
+	timeouts := map[string]time.Duration{
+		"server.read_header_timeout":             c.Server.ReadHeaderTimeout,
+		"server.read_timeout":                    c.Server.ReadTimeout,
+		"server.write_timeout":                   c.Server.WriteTimeout,
+		"server.idle_timeout":                    c.Server.IdleTimeout,
+		"server.shutdown_timeout":                c.Server.ShutdownTimeout,
+		"server.readiness_timeout":               c.Server.ReadinessTimeout,
+		"events.outbox_poll_interval":            c.Events.OutboxPollInterval,
+		"webhook.initial_backoff":                c.Webhook.InitialBackoff,
+		"webhook.max_backoff":                    c.Webhook.MaxBackoff,
+		"webhook.timeout":                        c.Webhook.Timeout,
+		"webhook.rescan_interval":                c.Webhook.RescanInterval,
+		"tasks.scan_interval":                    c.Tasks.ScanInterval,
+		"tags.suggestion_cache_ttl":              c.Tags.SuggestionCacheTTL,
+		"attachments.extraction_rescan_interval": c.Attachments.ExtractionRescanInterval,
+	}
+	for _, name := range slices.Sorted(maps.Keys(timeouts)) {
+		if timeouts[name] <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be positive, got %s", name, timeouts[name]))
+		}
+	}
+	if !slices.Contains([]string{"debug", "info", "warn", "error"}, strings.ToLower(c.Log.Level)) {
+		errs = append(errs, fmt.Errorf("log.level must be one of debug, info, warn or error, got %q", c.Log.Level))
+	}
+	if !slices.Contains([]string{"json", "text"}, strings.ToLower(c.Log.Format)) {
+		errs = append(errs, fmt.Errorf("log.format must be json or text, got %q", c.Log.Format))
+	}
+	if c.Server.MaxBodyBytes <= 0 {
+		errs = append(errs, fmt.Errorf("server.max_body_bytes must be positive, got %d", c.Server.MaxBodyBytes))
+	}
+	budgets := map[string]RateBudget{
+		"rate_limit.key_read":  c.RateLimit.KeyRead,
+		"rate_limit.key_write": c.RateLimit.KeyWrite,
+		"rate_limit.ip_read":   c.RateLimit.IPRead,
+		"rate_limit.ip_write":  c.RateLimit.IPWrite,
+	}
+	for _, name := range slices.Sorted(maps.Keys(budgets)) {
+		if c.RateLimit.Enabled && (budgets[name].RequestsPerMinute <= 0 || budgets[name].Burst <= 0) {
+			errs = append(errs, fmt.Errorf("%s needs a positive requests_per_minute and burst", name))
+		}
+	}
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin == "*" {
+			if c.CORS.AllowCredentials {
+				errs = append(errs, errors.New("cors.allowed_origins cannot contain * when cors.allow_credentials is set"))
+			}
+			continue
+		}
+		if !validOrigin(origin) {
+			errs = append(errs, fmt.Errorf("cors.allowed_origins entry %q must look like https://host or chrome-extension://id", origin))
+		}
+	}
+	if c.CORS.MaxAge < 0 {
+		errs = append(errs, fmt.Errorf("cors.max_age cannot be negative, got %s", c.CORS.MaxAge))
+	}
+	if c.Events.Workers <= 0 || c.Events.QueueSize <= 0 || c.Events.OutboxBatchSize <= 0 {
+		errs = append(errs, errors.New("events.workers, events.queue_size and events.outbox_batch_size must be positive"))
+	}
+	if c.Webhook.Workers <= 0 || c.Webhook.QueueSize <= 0 || c.Webhook.MaxAttempts <= 0 {
+		errs = append(errs, errors.New("webhook.workers, webhook.queue_size and webhook.max_attempts must be positive"))
+	}
+	if c.Tasks.DigestHourUTC < 0 || c.Tasks.DigestHourUTC > 23 {
+		errs = append(errs, fmt.Errorf("tasks.digest_hour_utc must be between 0 and 23, got %d", c.Tasks.DigestHourUTC))
+	}
+	if !slices.Contains([]string{"none", "stdout", "otlp"}, c.Tracing.Exporter) {
+		errs = append(errs, fmt.Errorf("tracing.exporter must be none, stdout or otlp, got %q", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("tracing.sample_ratio must be between 0 and 1, got %v", c.Tracing.SampleRatio))
+	}
+	if c.Tracing.ServiceName == "" {
+		errs = append(errs, errors.New("tracing.service_name is required"))
+	}
+	switch c.Attachments.Store {
+	case "local":
+		if c.Attachments.LocalDir == "" {
+			errs = append(errs, errors.New("attachments.local_dir is required for the local store"))
+		}
+	case "gcs":
+		if c.Attachments.Bucket == "" {
+			errs = append(errs, errors.New("attachments.bucket is required for the gcs store (set ATTACHMENTS_BUCKET)"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("attachments.store must be local or gcs, got %q", c.Attachments.Store))
+	}
+	if c.Attachments.MaxBytes <= 0 {
+		errs = append(errs, fmt.Errorf("attachments.max_bytes must be positive, got %d", c.Attachments.MaxBytes))
+	}
+	if c.Attachments.ExtractionWorkers <= 0 || c.Attachments.ExtractionQueueSize <= 0 {
+		errs = append(errs, errors.New("attachments.extraction_workers and attachments.extraction_queue_size must be positive"))
+	}
+	if c.Idempotency.TTL <= 0 {
+		errs = append(errs, fmt.Errorf("idempotency.ttl must be positive, got %s", c.Idempotency.TTL))
+	}
+	return errors.Join(errs...)
+}
+
+// validOrigin accepts scheme://host[:port] without path, which is what
+// browsers send in the Origin header.
+func validOrigin(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	if u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "chrome-extension" && u.Scheme != "moz-extension" {
+		return false
+	}
+	return u.Path == "" && u.RawQuery == "" && u.Fragment == ""
+}
@@ -0,0 +1,169 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func envFrom(values map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := values[name]
+		return value, ok
+	}
+}
+
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte(content), 0o600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return path
+}
+
+func TestLoadDefaults(t *testing.T) {
+	cfg, err := load(nil, envFrom(map[string]string{"API_TOKEN": "secret", "ADMIN_TOKEN": "admin"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Server.Port != 8080 {
+		t.Errorf("expected port 8080, got %d", cfg.Server.Port)
+	}
+
+	if cfg.Idempotency.TTL != 24*time.Hour {
+		t.Errorf("expected ttl 24h, got %s", cfg.Idempotency.TTL)
+	}
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := writeConfigFile(t, `
+server:
+  port: 9000
+  api_token: from-file
+  admin_token: admin-from-file
+firestore:
+  project_id: file-project
+idempotency:
+  ttl: 1h
+`)
+
+	cfg, err := load(
+		[]string{"-config", path, "-firestore-project", "flag-project"},
+		envFrom(map[string]string{"PORT": "9100"}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Server.Port != 9100 {
+		t.Errorf("expected env port 9100, got %d", cfg.Server.Port)
+	}
+
+	if cfg.Server.APIToken != "from-file" {
+		t.Errorf("expected token from file, got %s", cfg.Server.APIToken)
+	}
+
+	if cfg.Firestore.ProjectID != "flag-project" {
+		t.Errorf("expected project from flag, got %s", cfg.Firestore.ProjectID)
+	}
+
+	if cfg.Idempotency.TTL != time.Hour {
+		t.Errorf("expected ttl 1h, got %s", cfg.Idempotency.TTL)
+	}
+}
+
+func TestLoadReportsEveryError(t *testing.T) {
+	_, err := load([]string{"-port", "70000"}, envFrom(map[string]string{"IDEMPOTENCY_TTL": "soon"}))
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	for _, expected := range []string{"IDEMPOTENCY_TTL", "server.port", "server.api_token"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to mention %s, got %v", expected, err)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg := Default()
+	cfg.Server.Port = 0
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	for _, expected := range []string{"server.port", "server.api_token"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to mention %s, got %v", expected, err)
+		}
+	}
+}
+
+func TestValidateRecruiterToken(t *testing.T) {
+	cfg := Default()
+	cfg.Server.APIToken = "secret"
+	cfg.Server.AdminToken = "admin"
+	cfg.Server.RecruiterToken = "secret"
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "server.recruiter_token") {
+		t.Errorf("expected the shared token to be rejected, got %v", err)
+	}
+}
+
+func TestLoadUnknownFileKey(t *testing.T) {
+	path := writeConfigFile(t, "server:\n  prot: 9000\n")
+
+	_, err := load([]string{"-config", path}, envFrom(map[string]string{"API_TOKEN": "secret", "ADMIN_TOKEN": "admin"}))
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+func TestValidateCORSOrigins(t *testing.T) {
+	cfg := Default()
+	cfg.Server.APIToken = "secret"
+	cfg.Server.AdminToken = "admin"
+	cfg.CORS.AllowedOrigins = []string{"chrome-extension://abcdefghijklmnop", "https://talent.example.com"}
+
+	err := cfg.Validate()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cfg.CORS.AllowedOrigins = []string{"talent.example.com/path", "*"}
+	cfg.CORS.AllowCredentials = true
+
+	err = cfg.Validate()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	for _, expected := range []string{"talent.example.com/path", "allow_credentials"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to mention %s, got %v", expected, err)
+		}
+	}
+}
+
+func TestValidateAdminToken(t *testing.T) {
+	cfg := Default()
+	cfg.Server.APIToken = "secret"
+
+	err := cfg.Validate()
+	if err != nil {
+		t.Errorf("expected the admin token to be optional, got %v", err)
+	}
+
+	cfg.Server.AdminToken = "secret"
+	err = cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "server.admin_token must differ") {
+		t.Errorf("expected the shared token to be rejected, got %v", err)
+	}
+}
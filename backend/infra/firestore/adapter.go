@@ -2,12 +2,12 @@ package firestore
 
 import (
 	"context"
-	"encoding/base64"
-	"errors"
-	"time"
+	"slices"
 
 	"cloud.google.com/go/firestore"
 	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/infra/config"
 	"github.com/google/uuid"
 	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
@@ -19,80 +19,200 @@ type TalentDB struct {
 	project  string
 }
 
-func NewTalentDB(client *firestore.Client, projectID string) *TalentDB {
+func NewTalentDB(client *firestore.Client, cfg config.FirestoreConfig) *TalentDB {
 	return &TalentDB{
 		fsClient: client,
-		project:  projectID,
+		project:  cfg.ProjectID,
 	}
 }
 
-func (db *TalentDB) Save(ctx context.Context, talent domain.Talent) error {
-	t, err := db.GetTalentById(ctx, talent.Id.String())
-	if err != nil && err.Error() != "talent not found" {
+func (db *TalentDB) Save(ctx context.Context, talent *domain.Talent) error {
+	ref := db.fsClient.Collection("talents").Doc(talent.Id.String())
+	// pulled outside the transaction function, which may run more than once
+	events := talent.PullEvents()
+	// the talent is only updated once committed, the function works on a copy
+	var version int64
+	err := db.fsClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := storedDoc(tx, ref)
+		if err != nil {
+			return err
+		}
+		current := versionOf(doc)
+		if current != talent.Version {
+			return domain.ErrVersionConflict
+		}
+		emails, err := db.reserveEmails(tx, talent.Id.String(), storedEmails(doc), talent.Contacts.Emails)
+		if err != nil {
+			return err
+		}
+
+		stored := *talent
+		stored.Version = current + 1
+		err = tx.Set(ref, &stored)
+		if err != nil {
+			return err
+		}
+		err = emails.write(tx)
+		if err != nil {
+			return err
+		}
+		version = stored.Version
+		return writeOutbox(db.fsClient, tx, snapshotEvents(events, &stored)...)
+	})
+	if err != nil {
 		return err
 	}
-	if t != nil {
-		_, err := db.fsClient.Collection("talents").Doc(talent.Id.String()).Set(ctx, talent)
+	talent.Version = version
+	return nil
+}
+
+// snapshotEvents points the events at the state being committed, so their
+// payload carries the new version instead of the one the save started from.
+func snapshotEvents(events []domain.TalentEvent, stored *domain.Talent) []domain.TalentEvent {
+	snapshots := make([]domain.TalentEvent, len(events))
+	for i, event := range events {
+		if event.Talent != nil {
+			event.Talent = stored
+		}
+		snapshots[i] = event
+	}
+	return snapshots
+}
+
+func (db *TalentDB) Delete(ctx context.Context, id string, version int64) error {
+	ref := db.fsClient.Collection("talents").Doc(id)
+	return db.fsClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := storedDoc(tx, ref)
+		if err != nil {
+			return err
+		}
+		current := versionOf(doc)
+		if current == 0 {
+			return domain.ErrTalentNotFound
+		}
+		if current != version {
+			return domain.ErrVersionConflict
+		}
+		emails, err := db.reserveEmails(tx, id, storedEmails(doc), nil)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Delete(ref)
+		if err != nil {
+			return err
+		}
+		err = emails.write(tx)
 		if err != nil {
 			return err
 		}
+		return writeOutbox(db.fsClient, tx, domain.NewTalentEvent(domain.EventTalentDeleted, id, nil))
+	})
+}
+
+// storedVersion returns zero for missing documents. Documents written before
+// versioning was introduced are treated as version one.
+func storedVersion(tx *firestore.Transaction, ref *firestore.DocumentRef) (int64, error) {
+	doc, err := storedDoc(tx, ref)
+	if err != nil {
+		return 0, err
+	}
+	return versionOf(doc), nil
+}
+
+// storedDoc returns nil for missing documents.
+func storedDoc(tx *firestore.Transaction, ref *firestore.DocumentRef) (*firestore.DocumentSnapshot, error) {
+	doc, err := tx.Get(ref)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
 	}
-	_, err = db.fsClient.Collection("talents").Doc(talent.Id.String()).Create(ctx, talent)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return doc, nil
 }
 
-func (db *TalentDB) GetTalents(ctx context.Context, limit int, cursor string) ([]domain.Talent, string, error) {
-	var talents []domain.Talent
-	var lastCapturedAt time.Time
-	q := db.fsClient.Collection("talents").OrderBy("captured_at", firestore.Desc)
+func versionOf(doc *firestore.DocumentSnapshot) int64 {
+	if doc == nil {
+		return 0
+	}
+	version, err := doc.DataAt("version")
+	if err != nil {
+		return 1
+	}
+	v, ok := version.(int64)
+	if !ok || v == 0 {
+		return 1
+	}
+	return v
+}
+
+// sortPaths maps the sort keys to document fields. Firestore leaves out of
+// an ordered query the documents missing the field: talents stored before
+// updated_at or scorecards existed only show up sorted by them once saved
+// again or after BackfillSortFields (go run ./cmd/backfill). Sorting by two
+// keys needs the indexes of TalentSortIndexes.
+var sortPaths = map[string]string{
+	domain.SortCapturedAt:     "captured_at",
+	domain.SortUpdatedAt:      "updated_at",
+	domain.SortFullName:       "full_name",
+	domain.SortCurrentCompany: "current_company",
+	domain.SortRating:         "scorecards.average",
+}
+
+func (db *TalentDB) GetTalents(ctx context.Context, limit int, cursor string, sort domain.TalentSort) ([]domain.Talent, string, error) {
+	q := db.fsClient.Collection("talents").Query
+	direction := firestore.Asc
+	for _, key := range sort {
+		direction = firestore.Asc
+		if key.Desc {
+			direction = firestore.Desc
+		}
+		q = q.OrderBy(sortPaths[key.Field], direction)
+	}
+	q = q.OrderBy(firestore.DocumentID, direction)
 
 	if cursor != "" {
-		var cursorData time.Time
-		if decoded, err := base64.StdEncoding.DecodeString(cursor); err == nil {
-			if err := cursorData.UnmarshalText(decoded); err == nil {
-				q = q.StartAfter(cursorData)
-			}
+		values, id, err := sort.DecodeCursor(cursor)
+		if err != nil {
+			return nil, "", err
 		}
+		q = q.StartAfter(append(values, id)...)
 	}
 
-	q.Limit(limit)
-	iter := q.Documents(ctx)
+	iter := q.Limit(limit).Documents(ctx)
 	defer iter.Stop()
 
+	var talents []domain.Talent
 	for {
-		if len(talents) >= limit {
+		doc, err := iter.Next()
+		if err == iterator.Done {
 			break
 		}
-
-		doc, err := iter.Next()
-
 		if err != nil {
-			if err == iterator.Done {
-				break
-			}
 			return nil, "", err
 		}
 
 		var talent domain.Talent
 		err = doc.DataTo(&talent)
 		if err != nil {
+			logging.FromContext(ctx).Warn("skipping unreadable talent document", "document_id", doc.Ref.ID, "error", err)
 			continue
 		}
 		talent.Id, _ = uuid.Parse(doc.Ref.ID)
+		if talent.Version == 0 {
+			talent.Version = 1
+		}
 		talents = append(talents, talent)
-		lastCapturedAt = talent.CapturedAt
 	}
 
 	var nextCursor string
-	if !lastCapturedAt.IsZero() && len(talents) == limit {
-		encoded, err := lastCapturedAt.MarshalText()
+	if len(talents) == limit {
+		var err error
+		nextCursor, err = sort.EncodeCursor(&talents[len(talents)-1])
 		if err != nil {
 			return nil, "", err
 		}
-		nextCursor = base64.StdEncoding.EncodeToString(encoded)
 	}
 
 	return talents, nextCursor, nil
@@ -101,7 +221,7 @@ func (db *TalentDB) GetTalents(ctx context.Context, limit int, cursor string) ([
 func (db *TalentDB) GetTalentById(ctx context.Context, id string) (*domain.Talent, error) {
 	doc, err := db.fsClient.Collection("talents").Doc(id).Get(ctx)
 	if status.Code(err) == codes.NotFound {
-		return nil, errors.New("talent not found")
+		return nil, domain.ErrTalentNotFound
 	}
 	if err != nil && status.Code(err) != codes.NotFound {
 		return nil, err
@@ -116,5 +236,67 @@ func (db *TalentDB) GetTalentById(ctx context.Context, id string) (*domain.Talen
 	if err != nil {
 		return nil, err
 	}
+	if talent.Version == 0 {
+		talent.Version = 1
+	}
 	return &talent, nil
 }
+
+func (db *TalentDB) GetTalentsByTags(ctx context.Context, tags []string, limit int) ([]domain.Talent, error) {
+	return db.getTalentsByAny(ctx, "tags", tags, limit)
+}
+
+func (db *TalentDB) FindTalentsByContact(ctx context.Context, keys []string, limit int) ([]domain.Talent, error) {
+	return db.getTalentsByAny(ctx, "contact_keys", keys, limit)
+}
+
+// getTalentsByAny queries the values in chunks because array-contains-any
+// accepts at most 30 values.
+func (db *TalentDB) getTalentsByAny(ctx context.Context, field string, values []string, limit int) ([]domain.Talent, error) {
+	var talents []domain.Talent
+	seen := make(map[string]bool)
+	for chunk := range slices.Chunk(values, 30) {
+		iter := db.fsClient.Collection("talents").Where(field, "array-contains-any", chunk).Limit(limit).Documents(ctx)
+		for len(talents) < limit {
+			doc, err := iter.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				iter.Stop()
+				return nil, err
+			}
+			if seen[doc.Ref.ID] {
+				continue
+			}
+			seen[doc.Ref.ID] = true
+
+			var talent domain.Talent
+			err = doc.DataTo(&talent)
+			if err != nil {
+				logging.FromContext(ctx).Warn("skipping unreadable talent document", "document_id", doc.Ref.ID, "error", err)
+				continue
+			}
+			talent.Id, _ = uuid.Parse(doc.Ref.ID)
+			if talent.Version == 0 {
+				talent.Version = 1
+			}
+			talents = append(talents, talent)
+		}
+		iter.Stop()
+		if len(talents) >= limit {
+			break
+		}
+	}
+	return talents, nil
+}
+
+func (db *TalentDB) Ping(ctx context.Context) error {
+	iter := db.fsClient.Collection("talents").Limit(1).Documents(ctx)
+	defer iter.Stop()
+	_, err := iter.Next()
+	if err != nil && err != iterator.Done {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package firestore
+
+import (
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+func TestSnapshotEventsCarryTheCommittedVersion(t *testing.T) {
+	talent, err := domain.Create("https://linkedin.com/in/ana", "Data Engineer", "Ana Souza", "Data", "Acme", "Data Engineer", nil, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	events := append(talent.PullEvents(), domain.NewTalentEvent(domain.EventTalentDeleted, talent.Id.String(), nil))
+	stored := *talent
+	stored.Version = 1
+
+	snapshots := snapshotEvents(events, &stored)
+
+	if snapshots[0].Talent.Version != 1 || talent.Version != 0 {
+		t.Errorf("expected the event to carry version 1, got %d", snapshots[0].Talent.Version)
+	}
+	if snapshots[1].Talent != nil {
+		t.Error("expected events without a talent to stay without one")
+	}
+}
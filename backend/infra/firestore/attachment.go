@@ -0,0 +1,89 @@
+package firestore
+
+import (
+	"context"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AttachmentDB struct {
+	fsClient *firestore.Client
+}
+
+func NewAttachmentDB(client *firestore.Client) *AttachmentDB {
+	return &AttachmentDB{
+		fsClient: client,
+	}
+}
+
+func (db *AttachmentDB) SaveAttachment(ctx context.Context, attachment domain.Attachment) error {
+	_, err := db.fsClient.Collection("attachments").Doc(attachment.Id.String()).Set(ctx, attachment)
+	return err
+}
+
+func (db *AttachmentDB) GetAttachment(ctx context.Context, talentId string, id string) (*domain.Attachment, error) {
+	doc, err := db.fsClient.Collection("attachments").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, domain.ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var attachment domain.Attachment
+	err = doc.DataTo(&attachment)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.TalentId != talentId {
+		return nil, domain.ErrAttachmentNotFound
+	}
+	attachment.Id, _ = uuid.Parse(doc.Ref.ID)
+	return &attachment, nil
+}
+
+// GetAttachments needs the (talent_id, uploaded_at) index declared in
+// firestore.indexes.json.
+func (db *AttachmentDB) GetAttachments(ctx context.Context, talentId string) ([]domain.Attachment, error) {
+	iter := db.fsClient.Collection("attachments").
+		Where("talent_id", "==", talentId).
+		OrderBy("uploaded_at", firestore.Desc).
+		Documents(ctx)
+	return readAttachments(iter)
+}
+
+func (db *AttachmentDB) GetPendingAttachments(ctx context.Context, limit int) ([]domain.Attachment, error) {
+	iter := db.fsClient.Collection("attachments").
+		Where("text_status", "==", domain.TextPending).
+		Limit(limit).
+		Documents(ctx)
+	return readAttachments(iter)
+}
+
+func readAttachments(iter *firestore.DocumentIterator) ([]domain.Attachment, error) {
+	defer iter.Stop()
+
+	var attachments []domain.Attachment
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		var attachment domain.Attachment
+		err = doc.DataTo(&attachment)
+		if err != nil {
+			return nil, err
+		}
+		attachment.Id, _ = uuid.Parse(doc.Ref.ID)
+		attachments = append(attachments, attachment)
+	}
+	return attachments, nil
+}
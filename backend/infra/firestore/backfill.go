@@ -0,0 +1,59 @@
+package firestore
+
+import (
+	"context"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BackfillSortFields fills the sort fields missing on talents stored before
+// they existed, which ordered queries would leave out: updated_at takes the
+// capture time and scorecards an empty summary. It returns the number of
+// talents updated. Talents saved meanwhile are skipped, Save writes every
+// field anyway.
+func (db *TalentDB) BackfillSortFields(ctx context.Context) (int, error) {
+	iter := db.fsClient.Collection("talents").Documents(ctx)
+	defer iter.Stop()
+
+	updated := 0
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			return updated, nil
+		}
+		if err != nil {
+			return updated, err
+		}
+
+		updates := missingSortFields(doc)
+		if len(updates) == 0 {
+			continue
+		}
+		_, err = doc.Ref.Update(ctx, updates, firestore.LastUpdateTime(doc.UpdateTime))
+		if status.Code(err) == codes.FailedPrecondition {
+			continue
+		}
+		if err != nil {
+			return updated, err
+		}
+		updated++
+	}
+}
+
+func missingSortFields(doc *firestore.DocumentSnapshot) []firestore.Update {
+	var updates []firestore.Update
+	if _, err := doc.DataAt("updated_at"); err != nil {
+		capturedAt, err := doc.DataAt("captured_at")
+		if err == nil {
+			updates = append(updates, firestore.Update{Path: "updated_at", Value: capturedAt})
+		}
+	}
+	if _, err := doc.DataAt("scorecards.average"); err != nil {
+		updates = append(updates, firestore.Update{Path: "scorecards", Value: domain.ScorecardSummary{}})
+	}
+	return updates
+}
@@ -0,0 +1,123 @@
+package firestore
+
+import (
+	"fmt"
+	"slices"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+const emailsCollection = "talent_emails"
+
+// emailReservation is stored once per email, so two talents cannot save the
+// same email even concurrently.
+type emailReservation struct {
+	TalentId string `firestore:"talent_id"`
+}
+
+// emailChanges holds what reserveEmails read, to be written once the
+// transaction has done all its reads.
+type emailChanges struct {
+	talentId string
+	reserve  []*firestore.DocumentRef
+	release  []*firestore.DocumentRef
+}
+
+// reserveEmails returns ErrContactConflict when one of the emails is reserved
+// by another talent. Emails the talent had before and no longer has are
+// released, as long as they are reserved by it.
+func (db *TalentDB) reserveEmails(tx *firestore.Transaction, talentId string, previous []string, emails []string) (*emailChanges, error) {
+	changes := &emailChanges{talentId: talentId}
+	var removed []string
+	for _, email := range previous {
+		if !slices.Contains(emails, email) {
+			removed = append(removed, email)
+		}
+	}
+
+	reservations, err := db.getEmailReservations(tx, append(slices.Clone(emails), removed...))
+	if err != nil {
+		return nil, err
+	}
+	for _, email := range emails {
+		owner := reservations[email].TalentId
+		if owner != "" && owner != talentId {
+			return nil, fmt.Errorf("%w: %s is used by talent %s", domain.ErrContactConflict, email, owner)
+		}
+		changes.reserve = append(changes.reserve, db.emailRef(email))
+	}
+	for _, email := range removed {
+		if reservations[email].TalentId == talentId {
+			changes.release = append(changes.release, db.emailRef(email))
+		}
+	}
+	return changes, nil
+}
+
+func (c *emailChanges) write(tx *firestore.Transaction) error {
+	for _, ref := range c.reserve {
+		err := tx.Set(ref, emailReservation{TalentId: c.talentId})
+		if err != nil {
+			return err
+		}
+	}
+	for _, ref := range c.release {
+		err := tx.Delete(ref)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *TalentDB) getEmailReservations(tx *firestore.Transaction, emails []string) (map[string]emailReservation, error) {
+	reservations := make(map[string]emailReservation, len(emails))
+	if len(emails) == 0 {
+		return reservations, nil
+	}
+	refs := make([]*firestore.DocumentRef, 0, len(emails))
+	for _, email := range emails {
+		refs = append(refs, db.emailRef(email))
+	}
+	docs, err := tx.GetAll(refs)
+	if err != nil {
+		return nil, err
+	}
+	for i, doc := range docs {
+		if !doc.Exists() {
+			continue
+		}
+		var reservation emailReservation
+		err = doc.DataTo(&reservation)
+		if err != nil {
+			return nil, err
+		}
+		reservations[emails[i]] = reservation
+	}
+	return reservations, nil
+}
+
+// storedEmails returns the emails of a stored talent document, nil when it
+// does not exist.
+func storedEmails(doc *firestore.DocumentSnapshot) []string {
+	if doc == nil || !doc.Exists() {
+		return nil
+	}
+	value, err := doc.DataAt("contacts.emails")
+	if err != nil {
+		return nil
+	}
+	values, _ := value.([]any)
+	emails := make([]string, 0, len(values))
+	for _, v := range values {
+		if email, ok := v.(string); ok {
+			emails = append(emails, email)
+		}
+	}
+	return emails
+}
+
+func (db *TalentDB) emailRef(email string) *firestore.DocumentRef {
+	return db.fsClient.Collection(emailsCollection).Doc(hashDocID(email))
+}
@@ -0,0 +1,70 @@
+package firestore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type IdempotencyDB struct {
+	fsClient *firestore.Client
+}
+
+func NewIdempotencyDB(client *firestore.Client) *IdempotencyDB {
+	return &IdempotencyDB{
+		fsClient: client,
+	}
+}
+
+// ReserveIdempotencyKey reads and writes in one transaction, so concurrent
+// requests with the same key cannot both reserve it.
+func (db *IdempotencyDB) ReserveIdempotencyKey(ctx context.Context, record domain.IdempotencyRecord) (*domain.IdempotencyRecord, error) {
+	ref := db.fsClient.Collection("idempotency_keys").Doc(hashDocID(record.Key))
+	var existing *domain.IdempotencyRecord
+	err := db.fsClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		existing = nil
+		doc, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			var stored domain.IdempotencyRecord
+			err = doc.DataTo(&stored)
+			if err != nil {
+				return err
+			}
+			if !stored.IsExpired(record.CreatedAt) {
+				existing = &stored
+				return nil
+			}
+		}
+		return tx.Set(ref, record)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// SaveIdempotencyRecord overwrites the pending record of the key.
+func (db *IdempotencyDB) SaveIdempotencyRecord(ctx context.Context, record domain.IdempotencyRecord) error {
+	_, err := db.fsClient.Collection("idempotency_keys").Doc(hashDocID(record.Key)).Set(ctx, record)
+	return err
+}
+
+func (db *IdempotencyDB) DeleteIdempotencyRecord(ctx context.Context, key string) error {
+	_, err := db.fsClient.Collection("idempotency_keys").Doc(hashDocID(key)).Delete(ctx)
+	return err
+}
+
+// client supplied keys and names may contain characters that are not valid in
+// document ids
+func hashDocID(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,95 @@
+package firestore
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+//go:generate go run ../../cmd/indexes -file ../../firestore.indexes.json
+
+// IndexFile is the firestore.indexes.json deployed with
+// firebase deploy --only firestore:indexes.
+type IndexFile struct {
+	Indexes        []Index           `json:"indexes"`
+	FieldOverrides []json.RawMessage `json:"fieldOverrides"`
+}
+
+type Index struct {
+	CollectionGroup string       `json:"collectionGroup"`
+	QueryScope      string       `json:"queryScope"`
+	Fields          []IndexField `json:"fields"`
+}
+
+type IndexField struct {
+	FieldPath string `json:"fieldPath"`
+	Order     string `json:"order"`
+}
+
+func (i Index) Equal(other Index) bool {
+	return i.CollectionGroup == other.CollectionGroup && i.QueryScope == other.QueryScope && slices.Equal(i.Fields, other.Fields)
+}
+
+// TalentSortIndexes returns the composite indexes of the talent list sorted
+// by two keys. Firestore reads an index backwards, so only the orders
+// starting with an ascending key are listed. Single keys use the automatic
+// indexes.
+func TalentSortIndexes() []Index {
+	var indexes []Index
+	fields := domain.TalentSortFields()
+	for _, first := range fields {
+		for _, second := range fields {
+			if first == second {
+				continue
+			}
+			for _, order := range []string{"ASCENDING", "DESCENDING"} {
+				indexes = append(indexes, Index{
+					CollectionGroup: "talents",
+					QueryScope:      "COLLECTION",
+					Fields: []IndexField{
+						{FieldPath: sortPaths[first], Order: "ASCENDING"},
+						{FieldPath: sortPaths[second], Order: order},
+					},
+				})
+			}
+		}
+	}
+	return indexes
+}
+
+// isTalentSortIndex reports whether the index only has sort fields of the
+// talents, so it was generated by TalentSortIndexes.
+func isTalentSortIndex(index Index) bool {
+	if index.CollectionGroup != "talents" {
+		return false
+	}
+	for _, field := range index.Fields {
+		if !slices.ContainsFunc(domain.TalentSortFields(), func(key string) bool { return sortPaths[key] == field.FieldPath }) {
+			return false
+		}
+	}
+	return true
+}
+
+func ReadIndexFile(path string) (*IndexFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file IndexFile
+	err = json.Unmarshal(data, &file)
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// WithTalentSortIndexes replaces the generated talent sort indexes, keeping
+// the other ones in place.
+func (f IndexFile) WithTalentSortIndexes() IndexFile {
+	indexes := slices.DeleteFunc(slices.Clone(f.Indexes), isTalentSortIndex)
+	f.Indexes = append(indexes, TalentSortIndexes()...)
+	return f
+}
@@ -0,0 +1,34 @@
+package firestore
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIndexFileHasTalentSortIndexes(t *testing.T) {
+	file, err := ReadIndexFile("../../firestore.indexes.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, index := range TalentSortIndexes() {
+		if !slices.ContainsFunc(file.Indexes, index.Equal) {
+			t.Fatalf("missing index %+v, run go generate ./infra/firestore", index)
+		}
+	}
+	if len(file.WithTalentSortIndexes().Indexes) != len(file.Indexes) {
+		t.Error("expected the index file to be up to date, run go generate ./infra/firestore")
+	}
+}
+
+func TestTalentSortIndexesCoverEverySortPath(t *testing.T) {
+	indexes := TalentSortIndexes()
+	// every ordered pair of keys, with the second key in both directions
+	if len(indexes) != 5*4*2 {
+		t.Errorf("expected 40 indexes, got %d", len(indexes))
+	}
+	for _, index := range indexes {
+		if !isTalentSortIndex(index) || index.Fields[0].Order != "ASCENDING" {
+			t.Errorf("unexpected index %+v", index)
+		}
+	}
+}
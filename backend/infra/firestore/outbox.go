@@ -0,0 +1,92 @@
+package firestore
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+)
+
+const outboxCollection = "talent_outbox"
+
+type outboxRecord struct {
+	Event     domain.TalentEvent `firestore:"event"`
+	CreatedAt time.Time          `firestore:"created_at"`
+}
+
+func writeOutbox(client *firestore.Client, tx *firestore.Transaction, events ...domain.TalentEvent) error {
+	for _, event := range events {
+		ref := client.Collection(outboxCollection).Doc(event.Id)
+		err := tx.Create(ref, outboxRecord{Event: event, CreatedAt: event.OccurredAt})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OutboxDB reads the events TalentDB and TaskDB write. Published events are
+// deleted, the outbox only holds what the relay has not handled yet.
+type OutboxDB struct {
+	fsClient *firestore.Client
+}
+
+func NewOutboxDB(client *firestore.Client) *OutboxDB {
+	return &OutboxDB{
+		fsClient: client,
+	}
+}
+
+func (db *OutboxDB) GetPendingEvents(ctx context.Context, limit int) ([]domain.TalentEvent, error) {
+	iter := db.fsClient.Collection(outboxCollection).OrderBy("created_at", firestore.Asc).Limit(limit).Documents(ctx)
+	defer iter.Stop()
+
+	var events []domain.TalentEvent
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var record outboxRecord
+		err = doc.DataTo(&record)
+		if err != nil {
+			return nil, err
+		}
+		event := record.Event
+		event.Id = doc.Ref.ID
+		if event.Talent != nil {
+			event.Talent.Id, _ = uuid.Parse(event.TalentId)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (db *OutboxDB) MarkPublished(ctx context.Context, eventIds []string) error {
+	bw := db.fsClient.BulkWriter(ctx)
+	jobs := make([]*firestore.BulkWriterJob, 0, len(eventIds))
+	for _, id := range eventIds {
+		job, err := bw.Delete(db.fsClient.Collection(outboxCollection).Doc(id))
+		if err != nil {
+			bw.End()
+			return err
+		}
+		jobs = append(jobs, job)
+	}
+	bw.End()
+
+	for _, job := range jobs {
+		_, err := job.Results()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package firestore
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SavedSearchDB struct {
+	fsClient *firestore.Client
+}
+
+func NewSavedSearchDB(client *firestore.Client) *SavedSearchDB {
+	return &SavedSearchDB{
+		fsClient: client,
+	}
+}
+
+func (db *SavedSearchDB) SaveSavedSearch(ctx context.Context, search domain.SavedSearch) error {
+	_, err := db.fsClient.Collection("saved_searches").Doc(search.Id.String()).Set(ctx, search)
+	return err
+}
+
+func (db *SavedSearchDB) GetSavedSearchById(ctx context.Context, id string) (*domain.SavedSearch, error) {
+	doc, err := db.fsClient.Collection("saved_searches").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, domain.ErrSavedSearchNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var search domain.SavedSearch
+	err = doc.DataTo(&search)
+	if err != nil {
+		return nil, err
+	}
+	search.Id, _ = uuid.Parse(doc.Ref.ID)
+	return &search, nil
+}
+
+// GetSavedSearches sorts in memory, as ordering the OR query would need an
+// index per branch and there are few searches per owner.
+func (db *SavedSearchDB) GetSavedSearches(ctx context.Context, owner string) ([]domain.SavedSearch, error) {
+	iter := db.fsClient.Collection("saved_searches").WhereEntity(ownedOrShared(owner)).Documents(ctx)
+	defer iter.Stop()
+
+	var searches []domain.SavedSearch
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var search domain.SavedSearch
+		err = doc.DataTo(&search)
+		if err != nil {
+			return nil, err
+		}
+		search.Id, _ = uuid.Parse(doc.Ref.ID)
+		searches = append(searches, search)
+	}
+	slices.SortFunc(searches, func(a, b domain.SavedSearch) int {
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	return searches, nil
+}
+
+func (db *SavedSearchDB) DeleteSavedSearch(ctx context.Context, id string) error {
+	_, err := db.fsClient.Collection("saved_searches").Doc(id).Delete(ctx, firestore.Exists)
+	if status.Code(err) == codes.NotFound {
+		return domain.ErrSavedSearchNotFound
+	}
+	return err
+}
+
+// ownedOrShared matches the documents of the owner and the shared ones, or
+// only the shared ones without an owner.
+func ownedOrShared(owner string) firestore.EntityFilter {
+	shared := firestore.PropertyFilter{Path: "shared", Operator: "==", Value: true}
+	if owner == "" {
+		return shared
+	}
+	return firestore.OrFilter{Filters: []firestore.EntityFilter{
+		firestore.PropertyFilter{Path: "owner", Operator: "==", Value: owner},
+		shared,
+	}}
+}
@@ -0,0 +1,56 @@
+package firestore
+
+import (
+	"context"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+)
+
+type ScorecardDB struct {
+	fsClient *firestore.Client
+}
+
+func NewScorecardDB(client *firestore.Client) *ScorecardDB {
+	return &ScorecardDB{
+		fsClient: client,
+	}
+}
+
+func (db *ScorecardDB) SaveScorecard(ctx context.Context, scorecard domain.Scorecard) error {
+	_, err := db.fsClient.Collection("scorecards").Doc(scorecard.Id.String()).Set(ctx, scorecard)
+	return err
+}
+
+// GetScorecards needs the composite indexes declared in
+// firestore.indexes.json.
+func (db *ScorecardDB) GetScorecards(ctx context.Context, talentId string, openingId string) ([]domain.Scorecard, error) {
+	q := db.fsClient.Collection("scorecards").Where("talent_id", "==", talentId)
+	if openingId != "" {
+		q = q.Where("opening_id", "==", openingId)
+	}
+	iter := q.OrderBy("created_at", firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+
+	var scorecards []domain.Scorecard
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var scorecard domain.Scorecard
+		err = doc.DataTo(&scorecard)
+		if err != nil {
+			return nil, err
+		}
+		scorecard.Id, _ = uuid.Parse(doc.Ref.ID)
+		scorecards = append(scorecards, scorecard)
+	}
+	return scorecards, nil
+}
@@ -0,0 +1,105 @@
+package firestore
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ShortlistDB struct {
+	fsClient *firestore.Client
+}
+
+func NewShortlistDB(client *firestore.Client) *ShortlistDB {
+	return &ShortlistDB{
+		fsClient: client,
+	}
+}
+
+func (db *ShortlistDB) SaveShortlist(ctx context.Context, shortlist *domain.Shortlist) error {
+	ref := db.fsClient.Collection("shortlists").Doc(shortlist.Id.String())
+	// same as TalentDB.Save, the shortlist is only updated once committed
+	var version int64
+	err := db.fsClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		current, err := storedVersion(tx, ref)
+		if err != nil {
+			return err
+		}
+		if current != shortlist.Version {
+			return domain.ErrShortlistConflict
+		}
+
+		stored := *shortlist
+		stored.Version = current + 1
+		version = stored.Version
+		return tx.Set(ref, &stored)
+	})
+	if err != nil {
+		return err
+	}
+	shortlist.Version = version
+	return nil
+}
+
+func (db *ShortlistDB) GetShortlistById(ctx context.Context, id string) (*domain.Shortlist, error) {
+	doc, err := db.fsClient.Collection("shortlists").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, domain.ErrShortlistNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return readShortlist(doc)
+}
+
+// GetShortlists sorts in memory for the same reason as GetSavedSearches.
+func (db *ShortlistDB) GetShortlists(ctx context.Context, owner string) ([]domain.Shortlist, error) {
+	iter := db.fsClient.Collection("shortlists").WhereEntity(ownedOrShared(owner)).Documents(ctx)
+	defer iter.Stop()
+
+	var shortlists []domain.Shortlist
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		shortlist, err := readShortlist(doc)
+		if err != nil {
+			return nil, err
+		}
+		shortlists = append(shortlists, *shortlist)
+	}
+	slices.SortFunc(shortlists, func(a, b domain.Shortlist) int {
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	return shortlists, nil
+}
+
+func (db *ShortlistDB) DeleteShortlist(ctx context.Context, id string) error {
+	_, err := db.fsClient.Collection("shortlists").Doc(id).Delete(ctx, firestore.Exists)
+	if status.Code(err) == codes.NotFound {
+		return domain.ErrShortlistNotFound
+	}
+	return err
+}
+
+func readShortlist(doc *firestore.DocumentSnapshot) (*domain.Shortlist, error) {
+	var shortlist domain.Shortlist
+	err := doc.DataTo(&shortlist)
+	if err != nil {
+		return nil, err
+	}
+	shortlist.Id, _ = uuid.Parse(doc.Ref.ID)
+	return &shortlist, nil
+}
@@ -0,0 +1,158 @@
+package firestore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/firestore/apiv1/firestorepb"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statsConcurrency bounds the count queries running at the same time.
+const statsConcurrency = 8
+
+type StatsDB struct {
+	fsClient *firestore.Client
+}
+
+func NewStatsDB(client *firestore.Client) *StatsDB {
+	return &StatsDB{
+		fsClient: client,
+	}
+}
+
+// GetStats runs one count aggregation per time bucket and per stage. Firestore
+// has no group by, so the top-N lists come from a scan that only reads the
+// tags, company and role fields. When aggregation queries are not supported,
+// as in older emulators, everything is computed from the scan.
+func (db *StatsDB) GetStats(ctx context.Context, query domain.StatsQuery) (*domain.Stats, error) {
+	stats, err := db.aggregate(ctx, query)
+	if status.Code(err) == codes.Unimplemented {
+		logging.FromContext(ctx).Warn("aggregation queries unavailable, computing stats in memory", "error", err)
+		return db.scan(ctx, query, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dimensions, err := db.scan(ctx, query, true)
+	if err != nil {
+		return nil, err
+	}
+	stats.TopTags = dimensions.TopTags
+	stats.TopCompanies = dimensions.TopCompanies
+	stats.TopRoles = dimensions.TopRoles
+	return stats, nil
+}
+
+func (db *StatsDB) capturedBetween(from time.Time, to time.Time) firestore.Query {
+	return db.fsClient.Collection("talents").
+		Where("captured_at", ">=", from).
+		Where("captured_at", "<", to)
+}
+
+func (db *StatsDB) aggregate(ctx context.Context, query domain.StatsQuery) (*domain.Stats, error) {
+	stats := &domain.Stats{
+		Captured: query.Buckets(),
+		Funnel:   make([]domain.NamedCount, len(domain.Stages)),
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(statsConcurrency)
+	g.Go(func() error {
+		count, err := db.count(gctx, db.capturedBetween(query.From, query.To))
+		stats.Total = count
+		return err
+	})
+	for i, bucket := range stats.Captured {
+		g.Go(func() error {
+			count, err := db.count(gctx, db.capturedBetween(bucket.Start, bucket.End))
+			stats.Captured[i].Count = count
+			return err
+		})
+	}
+	for i, stage := range domain.Stages {
+		stats.Funnel[i].Name = stage
+		if stage == domain.StageSourced {
+			continue
+		}
+		g.Go(func() error {
+			count, err := db.count(gctx, db.capturedBetween(query.From, query.To).Where("stage", "==", stage))
+			stats.Funnel[i].Count = count
+			return err
+		})
+	}
+	err := g.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	// documents written before stages existed have no stage field and are
+	// counted as sourced
+	sourced := stats.Total
+	for i, stage := range domain.Stages {
+		if stage != domain.StageSourced {
+			sourced -= stats.Funnel[i].Count
+		}
+	}
+	for i, stage := range domain.Stages {
+		if stage == domain.StageSourced {
+			stats.Funnel[i].Count = sourced
+		}
+	}
+	return stats, nil
+}
+
+func (db *StatsDB) count(ctx context.Context, q firestore.Query) (int64, error) {
+	result, err := q.NewAggregationQuery().WithCount("count").Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	value, ok := result["count"].(*firestorepb.Value)
+	if !ok {
+		return 0, fmt.Errorf("unexpected count result %T", result["count"])
+	}
+	return value.GetIntegerValue(), nil
+}
+
+// scan feeds the talents captured in the query range to a StatsAccumulator.
+// With dimensionsOnly it reads fewer fields and only fills the top-N lists.
+func (db *StatsDB) scan(ctx context.Context, query domain.StatsQuery, dimensionsOnly bool) (*domain.Stats, error) {
+	fields := []string{"tags", "current_company", "possible_role"}
+	if !dimensionsOnly {
+		fields = append(fields, "captured_at", "stage")
+	}
+	iter := db.capturedBetween(query.From, query.To).Select(fields...).Documents(ctx)
+	defer iter.Stop()
+
+	accumulator := domain.NewStatsAccumulator(query)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var talent domain.Talent
+		err = doc.DataTo(&talent)
+		if err != nil {
+			logging.FromContext(ctx).Warn("skipping unreadable talent document", "document_id", doc.Ref.ID, "error", err)
+			continue
+		}
+		if dimensionsOnly {
+			accumulator.AddDimensions(talent)
+		} else {
+			accumulator.Add(talent)
+		}
+	}
+	return accumulator.Result(), nil
+}
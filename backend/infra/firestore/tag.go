@@ -0,0 +1,169 @@
+package firestore
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxTagCandidates bounds the prefix matches sorted by usage in SearchTags.
+const maxTagCandidates = 200
+
+type TagDB struct {
+	fsClient *firestore.Client
+}
+
+func NewTagDB(client *firestore.Client) *TagDB {
+	return &TagDB{
+		fsClient: client,
+	}
+}
+
+// tagRecord adds the key of the name, used for prefix searches.
+type tagRecord struct {
+	domain.Tag
+	NameKey string `firestore:"name_key"`
+}
+
+// SaveTag merges every field but usage_count, which is only changed by
+// AdjustUsage increments.
+func (db *TagDB) SaveTag(ctx context.Context, tag domain.Tag) error {
+	_, err := db.fsClient.Collection("tags").Doc(tag.Id.String()).Set(ctx, tagRecord{Tag: tag, NameKey: domain.TagKey(tag.Name)},
+		firestore.Merge([]string{"name"}, []string{"name_key"}, []string{"aliases"}, []string{"category"}, []string{"keys"},
+			[]string{"created_at"}, []string{"updated_at"}))
+	return err
+}
+
+func (db *TagDB) GetTagById(ctx context.Context, id string) (*domain.Tag, error) {
+	doc, err := db.fsClient.Collection("tags").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, domain.ErrTagNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return tagFromDoc(doc)
+}
+
+func (db *TagDB) FindTagsByKeys(ctx context.Context, keys []string) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	seen := make(map[string]bool)
+	for chunk := range slices.Chunk(keys, 30) {
+		found, err := db.query(ctx, db.fsClient.Collection("tags").Where("keys", "array-contains-any", chunk))
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range found {
+			if !seen[tag.Id.String()] {
+				seen[tag.Id.String()] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags, nil
+}
+
+func (db *TagDB) SearchTags(ctx context.Context, prefix string, category string, limit int) ([]domain.Tag, error) {
+	q := db.fsClient.Collection("tags").Query
+	if category != "" {
+		q = q.Where("category", "==", category)
+	}
+	if key := domain.TagKey(prefix); key != "" {
+		q = q.Where("name_key", ">=", key).Where("name_key", "<", key+"\uf8ff")
+	}
+	tags, err := db.query(ctx, q.OrderBy("name_key", firestore.Asc).Limit(maxTagCandidates))
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(tags, func(a, b domain.Tag) int {
+		return cmp.Compare(b.UsageCount, a.UsageCount)
+	})
+	return tags[:min(limit, len(tags))], nil
+}
+
+func (db *TagDB) GetAllTags(ctx context.Context) ([]domain.Tag, error) {
+	return db.query(ctx, db.fsClient.Collection("tags").Query)
+}
+
+func (db *TagDB) DeleteTag(ctx context.Context, id string) error {
+	_, err := db.fsClient.Collection("tags").Doc(id).Delete(ctx, firestore.Exists)
+	if status.Code(err) == codes.NotFound {
+		return domain.ErrTagNotFound
+	}
+	return err
+}
+
+func (db *TagDB) AdjustUsage(ctx context.Context, deltas map[string]int64) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+	batch := db.fsClient.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	for id, delta := range deltas {
+		if delta == 0 {
+			continue
+		}
+		job, err := batch.Update(db.fsClient.Collection("tags").Doc(id), []firestore.Update{
+			{Path: "usage_count", Value: firestore.Increment(delta)},
+		})
+		if err != nil {
+			batch.End()
+			return err
+		}
+		jobs = append(jobs, job)
+	}
+	batch.End()
+
+	for _, job := range jobs {
+		_, err := job.Results()
+		// tags deleted by a merge in the meantime
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *TagDB) query(ctx context.Context, q firestore.Query) ([]domain.Tag, error) {
+	iter := q.Documents(ctx)
+	defer iter.Stop()
+
+	var tags []domain.Tag
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		tag, err := tagFromDoc(doc)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, *tag)
+	}
+	return tags, nil
+}
+
+func tagFromDoc(doc *firestore.DocumentSnapshot) (*domain.Tag, error) {
+	var record tagRecord
+	err := doc.DataTo(&record)
+	if err != nil {
+		return nil, err
+	}
+	tag := record.Tag
+	tag.Id, err = uuid.Parse(doc.Ref.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
@@ -0,0 +1,150 @@
+package firestore
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TaskDB struct {
+	fsClient *firestore.Client
+}
+
+func NewTaskDB(client *firestore.Client) *TaskDB {
+	return &TaskDB{
+		fsClient: client,
+	}
+}
+
+func (db *TaskDB) SaveTask(ctx context.Context, task domain.FollowUpTask) error {
+	_, err := db.fsClient.Collection("tasks").Doc(task.Id.String()).Set(ctx, task)
+	return err
+}
+
+// MarkTaskOverdue reads and updates the task in a transaction, so a task
+// completed after the scheduler scan is not reopened and the event is only
+// written with the flag.
+func (db *TaskDB) MarkTaskOverdue(ctx context.Context, id string, now time.Time) (bool, error) {
+	ref := db.fsClient.Collection("tasks").Doc(id)
+	marked := false
+	err := db.fsClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		marked = false
+		doc, err := tx.Get(ref)
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var task domain.FollowUpTask
+		err = doc.DataTo(&task)
+		if err != nil {
+			return err
+		}
+		if !task.MarkOverdue(now) {
+			return nil
+		}
+		task.Id, _ = uuid.Parse(doc.Ref.ID)
+		marked = true
+		err = tx.Update(ref, []firestore.Update{{Path: "overdue", Value: true}})
+		if err != nil {
+			return err
+		}
+		return writeOutbox(db.fsClient, tx, domain.NewTaskOverdueEvent(task))
+	})
+	return marked, err
+}
+
+func (db *TaskDB) GetTaskById(ctx context.Context, id string) (*domain.FollowUpTask, error) {
+	doc, err := db.fsClient.Collection("tasks").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, domain.ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var task domain.FollowUpTask
+	err = doc.DataTo(&task)
+	if err != nil {
+		return nil, err
+	}
+	task.Id, _ = uuid.Parse(doc.Ref.ID)
+	return &task, nil
+}
+
+// GetTasks needs the composite indexes declared in firestore.indexes.json
+// (deploy with firebase deploy --only firestore:indexes).
+func (db *TaskDB) GetTasks(ctx context.Context, filter domain.TaskFilter) ([]domain.FollowUpTask, error) {
+	q := db.fsClient.Collection("tasks").Query
+	if filter.TalentId != "" {
+		q = q.Where("talent_id", "==", filter.TalentId)
+	}
+	if filter.Assignee != "" {
+		q = q.Where("assignee", "==", filter.Assignee)
+	}
+	if filter.Done != nil {
+		q = q.Where("done", "==", *filter.Done)
+	}
+	if !filter.DueBefore.IsZero() {
+		q = q.Where("due_at", "<", filter.DueBefore)
+	}
+	q = q.OrderBy("due_at", firestore.Asc)
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	iter := q.Documents(ctx)
+	defer iter.Stop()
+
+	var tasks []domain.FollowUpTask
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var task domain.FollowUpTask
+		err = doc.DataTo(&task)
+		if err != nil {
+			return nil, err
+		}
+		task.Id, _ = uuid.Parse(doc.Ref.ID)
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+type digestClaim struct {
+	Assignee  string    `firestore:"assignee"`
+	Day       string    `firestore:"day"`
+	ClaimedAt time.Time `firestore:"claimed_at"`
+}
+
+func (db *TaskDB) ClaimDigest(ctx context.Context, digest domain.TaskDigest) (bool, error) {
+	ref := db.fsClient.Collection("task_digests").Doc(hashDocID(digest.Day + "/" + digest.Assignee))
+	claimed := false
+	err := db.fsClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		claimed = false
+		doc, err := storedDoc(tx, ref)
+		if err != nil || doc != nil {
+			return err
+		}
+		err = tx.Create(ref, digestClaim{Assignee: digest.Assignee, Day: digest.Day, ClaimedAt: time.Now().UTC()})
+		if err != nil {
+			return err
+		}
+		claimed = true
+		return writeOutbox(db.fsClient, tx, domain.NewTaskDigestEvent(digest))
+	})
+	return claimed, err
+}
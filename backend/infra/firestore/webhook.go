@@ -0,0 +1,153 @@
+package firestore
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type WebhookDB struct {
+	fsClient *firestore.Client
+}
+
+func NewWebhookDB(client *firestore.Client) *WebhookDB {
+	return &WebhookDB{
+		fsClient: client,
+	}
+}
+
+func (db *WebhookDB) SaveSubscription(ctx context.Context, subscription domain.WebhookSubscription) error {
+	_, err := db.fsClient.Collection("webhook_subscriptions").Doc(subscription.Id.String()).Set(ctx, subscription)
+	return err
+}
+
+func (db *WebhookDB) GetSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	iter := db.fsClient.Collection("webhook_subscriptions").OrderBy("created_at", firestore.Asc).Documents(ctx)
+	defer iter.Stop()
+
+	var subscriptions []domain.WebhookSubscription
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var subscription domain.WebhookSubscription
+		err = doc.DataTo(&subscription)
+		if err != nil {
+			return nil, err
+		}
+		subscription.Id, _ = uuid.Parse(doc.Ref.ID)
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+func (db *WebhookDB) GetSubscriptionById(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	doc, err := db.fsClient.Collection("webhook_subscriptions").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, domain.ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var subscription domain.WebhookSubscription
+	err = doc.DataTo(&subscription)
+	if err != nil {
+		return nil, err
+	}
+	subscription.Id, err = uuid.Parse(doc.Ref.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+func (db *WebhookDB) DeleteSubscription(ctx context.Context, id string) error {
+	ref := db.fsClient.Collection("webhook_subscriptions").Doc(id)
+	_, err := ref.Delete(ctx, firestore.Exists)
+	if status.Code(err) == codes.NotFound {
+		return domain.ErrWebhookNotFound
+	}
+	return err
+}
+
+func (db *WebhookDB) SaveDelivery(ctx context.Context, delivery domain.WebhookDelivery) error {
+	_, err := db.fsClient.Collection("webhook_deliveries").Doc(delivery.Id.String()).Set(ctx, delivery)
+	return err
+}
+
+func (db *WebhookDB) GetDeliveryById(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	doc, err := db.fsClient.Collection("webhook_deliveries").Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, domain.ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var delivery domain.WebhookDelivery
+	err = doc.DataTo(&delivery)
+	if err != nil {
+		return nil, err
+	}
+	delivery.Id, err = uuid.Parse(doc.Ref.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+func (db *WebhookDB) GetDeliveries(ctx context.Context, subscriptionId string, deliveryStatus string, limit int) ([]domain.WebhookDelivery, error) {
+	q := db.fsClient.Collection("webhook_deliveries").Query
+	if subscriptionId != "" {
+		q = q.Where("subscription_id", "==", subscriptionId)
+	}
+	if deliveryStatus != "" {
+		q = q.Where("status", "==", deliveryStatus)
+	}
+	return readDeliveries(q.OrderBy("created_at", firestore.Desc).Limit(limit).Documents(ctx))
+}
+
+func (db *WebhookDB) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	return readDeliveries(db.fsClient.Collection("webhook_deliveries").
+		Where("status", "==", domain.DeliveryPending).
+		Where("next_attempt_at", "<=", now).
+		OrderBy("next_attempt_at", firestore.Asc).
+		Limit(limit).
+		Documents(ctx))
+}
+
+func readDeliveries(iter *firestore.DocumentIterator) ([]domain.WebhookDelivery, error) {
+	defer iter.Stop()
+
+	var deliveries []domain.WebhookDelivery
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var delivery domain.WebhookDelivery
+		err = doc.DataTo(&delivery)
+		if err != nil {
+			return nil, err
+		}
+		delivery.Id, _ = uuid.Parse(doc.Ref.ID)
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
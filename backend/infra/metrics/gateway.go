@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+)
+
+// TalentGateway decorates a domain.TalentGateway recording latency and
+// errors of every call.
+type TalentGateway struct {
+	next    domain.TalentGateway
+	metrics *Metrics
+}
+
+func NewTalentGateway(next domain.TalentGateway, metrics *Metrics) *TalentGateway {
+	return &TalentGateway{
+		next:    next,
+		metrics: metrics,
+	}
+}
+
+func (g *TalentGateway) Save(ctx context.Context, talent *domain.Talent) error {
+	start := time.Now()
+	isNew := talent.Version == 0
+	err := g.next.Save(ctx, talent)
+	g.metrics.observeGateway("save", start, err)
+	if err == nil && isNew {
+		g.metrics.TalentsCreated.WithLabelValues(FunctionLabel(talent)).Inc()
+	}
+	return err
+}
+
+func (g *TalentGateway) Delete(ctx context.Context, id string, version int64) error {
+	start := time.Now()
+	err := g.next.Delete(ctx, id, version)
+	g.metrics.observeGateway("delete", start, err)
+	return err
+}
+
+func (g *TalentGateway) GetTalents(ctx context.Context, limit int, cursor string, sort domain.TalentSort) ([]domain.Talent, string, error) {
+	start := time.Now()
+	talents, nextCursor, err := g.next.GetTalents(ctx, limit, cursor, sort)
+	g.metrics.observeGateway("get_talents", start, err)
+	return talents, nextCursor, err
+}
+
+func (g *TalentGateway) GetTalentById(ctx context.Context, id string) (*domain.Talent, error) {
+	start := time.Now()
+	talent, err := g.next.GetTalentById(ctx, id)
+	g.metrics.observeGateway("get_talent_by_id", start, err)
+	return talent, err
+}
+
+func (g *TalentGateway) GetTalentsByTags(ctx context.Context, tags []string, limit int) ([]domain.Talent, error) {
+	start := time.Now()
+	talents, err := g.next.GetTalentsByTags(ctx, tags, limit)
+	g.metrics.observeGateway("get_talents_by_tags", start, err)
+	return talents, err
+}
+
+func (g *TalentGateway) FindTalentsByContact(ctx context.Context, keys []string, limit int) ([]domain.Talent, error) {
+	start := time.Now()
+	talents, err := g.next.FindTalentsByContact(ctx, keys, limit)
+	g.metrics.observeGateway("find_talents_by_contact", start, err)
+	return talents, err
+}
+
+func (g *TalentGateway) Ping(ctx context.Context) error {
+	start := time.Now()
+	err := g.next.Ping(ctx)
+	g.metrics.observeGateway("ping", start, err)
+	return err
+}
+
+type IdempotencyGateway struct {
+	next    domain.IdempotencyGateway
+	metrics *Metrics
+}
+
+func NewIdempotencyGateway(next domain.IdempotencyGateway, metrics *Metrics) *IdempotencyGateway {
+	return &IdempotencyGateway{
+		next:    next,
+		metrics: metrics,
+	}
+}
+
+func (g *IdempotencyGateway) ReserveIdempotencyKey(ctx context.Context, record domain.IdempotencyRecord) (*domain.IdempotencyRecord, error) {
+	start := time.Now()
+	existing, err := g.next.ReserveIdempotencyKey(ctx, record)
+	g.metrics.observeGateway("reserve_idempotency_key", start, err)
+	return existing, err
+}
+
+func (g *IdempotencyGateway) SaveIdempotencyRecord(ctx context.Context, record domain.IdempotencyRecord) error {
+	start := time.Now()
+	err := g.next.SaveIdempotencyRecord(ctx, record)
+	g.metrics.observeGateway("save_idempotency_record", start, err)
+	return err
+}
+
+func (g *IdempotencyGateway) DeleteIdempotencyRecord(ctx context.Context, key string) error {
+	start := time.Now()
+	err := g.next.DeleteIdempotencyRecord(ctx, key)
+	g.metrics.observeGateway("delete_idempotency_record", start, err)
+	return err
+}
+
+// not found answers are expected outcomes and are not counted as errors
+func (m *Metrics) observeGateway(operation string, start time.Time, err error) {
+	m.GatewayDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	if err != nil && !errors.Is(err, domain.ErrTalentNotFound) {
+		m.GatewayErrors.WithLabelValues(operation).Inc()
+	}
+}
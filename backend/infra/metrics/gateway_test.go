@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type stubTalentGateway struct {
+	err error
+}
+
+func (g *stubTalentGateway) Save(ctx context.Context, talent *domain.Talent) error {
+	if g.err != nil {
+		return g.err
+	}
+	talent.Version++
+	return nil
+}
+func (g *stubTalentGateway) Delete(ctx context.Context, id string, version int64) error {
+	return g.err
+}
+func (g *stubTalentGateway) GetTalents(ctx context.Context, limit int, cursor string, sort domain.TalentSort) ([]domain.Talent, string, error) {
+	return nil, "", g.err
+}
+func (g *stubTalentGateway) GetTalentById(ctx context.Context, id string) (*domain.Talent, error) {
+	return nil, g.err
+}
+func (g *stubTalentGateway) GetTalentsByTags(ctx context.Context, tags []string, limit int) ([]domain.Talent, error) {
+	return nil, g.err
+}
+func (g *stubTalentGateway) FindTalentsByContact(ctx context.Context, keys []string, limit int) ([]domain.Talent, error) {
+	return nil, g.err
+}
+func (g *stubTalentGateway) Ping(ctx context.Context) error {
+	return g.err
+}
+
+func TestTalentGatewayCountsCreatedTalents(t *testing.T) {
+	m := New()
+	gateway := NewTalentGateway(&stubTalentGateway{}, m)
+
+	talent := &domain.Talent{PossibleRole: " Backend Engineer "}
+	_ = gateway.Save(context.Background(), talent)
+	_ = gateway.Save(context.Background(), talent)
+	_ = gateway.Save(context.Background(), &domain.Talent{PossibleRole: "Gerente Comercial Sênior de Contas Estratégicas"})
+
+	if got := testutil.ToFloat64(m.TalentsCreated.WithLabelValues(domain.FunctionBackend)); got != 1 {
+		t.Errorf("expected 1 backend talent created, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.TalentsCreated.WithLabelValues("unknown")); got != 1 {
+		t.Errorf("expected 1 talent without a known function, got %v", got)
+	}
+}
+
+func TestTalentGatewayCountsErrors(t *testing.T) {
+	m := New()
+
+	_, _, _ = NewTalentGateway(&stubTalentGateway{err: errors.New("unavailable")}, m).GetTalents(context.Background(), 10, "", domain.DefaultTalentSort)
+	_, _ = NewTalentGateway(&stubTalentGateway{err: domain.ErrTalentNotFound}, m).GetTalentById(context.Background(), "id")
+
+	if got := testutil.ToFloat64(m.GatewayErrors.WithLabelValues("get_talents")); got != 1 {
+		t.Errorf("expected 1 get_talents error, got %v", got)
+	}
+
+	if got := testutil.ToFloat64(m.GatewayErrors.WithLabelValues("get_talent_by_id")); got != 0 {
+		t.Errorf("expected not found not to be counted, got %v", got)
+	}
+}
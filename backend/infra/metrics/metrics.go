@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	HTTPRequests       *prometheus.CounterVec
+	HTTPDuration       *prometheus.HistogramVec
+	GatewayDuration    *prometheus.HistogramVec
+	GatewayErrors      *prometheus.CounterVec
+	TalentsCreated     *prometheus.CounterVec
+	DuplicatesRejected *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		HTTPRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "talentdb_http_requests_total",
+			Help: "HTTP requests by route, method and status code.",
+		}, []string{"route", "method", "status"}),
+		HTTPDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "talentdb_http_request_duration_seconds",
+			Help:    "HTTP request latency by route and method.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"route", "method"}),
+		GatewayDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "talentdb_gateway_operation_duration_seconds",
+			Help:    "Latency of storage gateway operations.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"operation"}),
+		GatewayErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "talentdb_gateway_errors_total",
+			Help: "Storage gateway operations that returned an error.",
+		}, []string{"operation"}),
+		TalentsCreated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "talentdb_talents_created_total",
+			Help: "Talents created by normalized role function.",
+		}, []string{"function"}),
+		DuplicatesRejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "talentdb_duplicates_rejected_total",
+			Help: "Create requests that did not create a new talent because they were repeated.",
+		}, []string{"reason"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.HTTPRequests,
+		m.HTTPDuration,
+		m.GatewayDuration,
+		m.GatewayErrors,
+		m.TalentsCreated,
+		m.DuplicatesRejected,
+	)
+	return m
+}
+
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// FunctionLabel labels a talent by its normalized role function, a fixed
+// set, as the possible_role itself is free text.
+func FunctionLabel(talent *domain.Talent) string {
+	if function := talent.NormalizedRole().Function; function != "" {
+		return function
+	}
+	return "unknown"
+}
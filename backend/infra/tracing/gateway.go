@@ -0,0 +1,116 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/allanCordeiro/talent-db/infra/tracing")
+
+// TalentGateway decorates a domain.TalentGateway with one span per call.
+type TalentGateway struct {
+	next domain.TalentGateway
+}
+
+func NewTalentGateway(next domain.TalentGateway) *TalentGateway {
+	return &TalentGateway{
+		next: next,
+	}
+}
+
+func (g *TalentGateway) Save(ctx context.Context, talent *domain.Talent) error {
+	ctx, span := tracer.Start(ctx, "TalentGateway.Save", trace.WithAttributes(attribute.String("talent.id", talent.Id.String())))
+	err := g.next.Save(ctx, talent)
+	end(span, err)
+	return err
+}
+
+func (g *TalentGateway) Delete(ctx context.Context, id string, version int64) error {
+	ctx, span := tracer.Start(ctx, "TalentGateway.Delete", trace.WithAttributes(attribute.String("talent.id", id)))
+	err := g.next.Delete(ctx, id, version)
+	end(span, err)
+	return err
+}
+
+func (g *TalentGateway) GetTalents(ctx context.Context, limit int, cursor string, sort domain.TalentSort) ([]domain.Talent, string, error) {
+	ctx, span := tracer.Start(ctx, "TalentGateway.GetTalents", trace.WithAttributes(attribute.Int("limit", limit), attribute.String("sort", sort.String())))
+	talents, nextCursor, err := g.next.GetTalents(ctx, limit, cursor, sort)
+	span.SetAttributes(attribute.Int("talents.count", len(talents)))
+	end(span, err)
+	return talents, nextCursor, err
+}
+
+func (g *TalentGateway) GetTalentById(ctx context.Context, id string) (*domain.Talent, error) {
+	ctx, span := tracer.Start(ctx, "TalentGateway.GetTalentById", trace.WithAttributes(attribute.String("talent.id", id)))
+	talent, err := g.next.GetTalentById(ctx, id)
+	end(span, err)
+	return talent, err
+}
+
+func (g *TalentGateway) GetTalentsByTags(ctx context.Context, tags []string, limit int) ([]domain.Talent, error) {
+	ctx, span := tracer.Start(ctx, "TalentGateway.GetTalentsByTags", trace.WithAttributes(attribute.StringSlice("tags", tags), attribute.Int("limit", limit)))
+	talents, err := g.next.GetTalentsByTags(ctx, tags, limit)
+	span.SetAttributes(attribute.Int("talents.count", len(talents)))
+	end(span, err)
+	return talents, err
+}
+
+func (g *TalentGateway) FindTalentsByContact(ctx context.Context, keys []string, limit int) ([]domain.Talent, error) {
+	ctx, span := tracer.Start(ctx, "TalentGateway.FindTalentsByContact", trace.WithAttributes(attribute.Int("keys.count", len(keys)), attribute.Int("limit", limit)))
+	talents, err := g.next.FindTalentsByContact(ctx, keys, limit)
+	span.SetAttributes(attribute.Int("talents.count", len(talents)))
+	end(span, err)
+	return talents, err
+}
+
+func (g *TalentGateway) Ping(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "TalentGateway.Ping")
+	err := g.next.Ping(ctx)
+	end(span, err)
+	return err
+}
+
+type IdempotencyGateway struct {
+	next domain.IdempotencyGateway
+}
+
+func NewIdempotencyGateway(next domain.IdempotencyGateway) *IdempotencyGateway {
+	return &IdempotencyGateway{
+		next: next,
+	}
+}
+
+func (g *IdempotencyGateway) ReserveIdempotencyKey(ctx context.Context, record domain.IdempotencyRecord) (*domain.IdempotencyRecord, error) {
+	ctx, span := tracer.Start(ctx, "IdempotencyGateway.ReserveIdempotencyKey")
+	existing, err := g.next.ReserveIdempotencyKey(ctx, record)
+	end(span, err)
+	return existing, err
+}
+
+func (g *IdempotencyGateway) SaveIdempotencyRecord(ctx context.Context, record domain.IdempotencyRecord) error {
+	ctx, span := tracer.Start(ctx, "IdempotencyGateway.SaveIdempotencyRecord")
+	err := g.next.SaveIdempotencyRecord(ctx, record)
+	end(span, err)
+	return err
+}
+
+func (g *IdempotencyGateway) DeleteIdempotencyRecord(ctx context.Context, key string) error {
+	ctx, span := tracer.Start(ctx, "IdempotencyGateway.DeleteIdempotencyRecord")
+	err := g.next.DeleteIdempotencyRecord(ctx, key)
+	end(span, err)
+	return err
+}
+
+func end(span trace.Span, err error) {
+	if err != nil && !errors.Is(err, domain.ErrTalentNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,410 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/infra/config"
+)
+
+// Dispatcher turns talent events into signed webhook deliveries. Every
+// delivery is stored before the first attempt so the history survives
+// restarts, and pending ones left behind by a restart or a full queue are
+// resumed by a rescan every interval. Handle is meant to be subscribed to
+// the event bus.
+type Dispatcher struct {
+	gateway        domain.WebhookGateway
+	client         *http.Client
+	workers        int
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	interval       time.Duration
+	queue          chan domain.WebhookDelivery
+	mu             sync.Mutex
+	// tracked holds the deliveries queued, waiting for a retry or being
+	// sent, so a rescan does not queue them twice.
+	tracked map[string]bool
+	wg      sync.WaitGroup
+}
+
+func NewDispatcher(cfg config.WebhookConfig, gateway domain.WebhookGateway) *Dispatcher {
+	return &Dispatcher{
+		gateway:        gateway,
+		client:         &http.Client{Timeout: cfg.Timeout},
+		workers:        cfg.Workers,
+		maxAttempts:    cfg.MaxAttempts,
+		initialBackoff: cfg.InitialBackoff,
+		maxBackoff:     cfg.MaxBackoff,
+		interval:       cfg.RescanInterval,
+		queue:          make(chan domain.WebhookDelivery, cfg.QueueSize),
+		tracked:        make(map[string]bool),
+	}
+}
+
+type eventPayload struct {
+	Id         string         `json:"id"`
+	Type       string         `json:"type"`
+	OccurredAt time.Time      `json:"occurred_at"`
+	TalentId   string         `json:"talent_id,omitempty"`
+	Talent     *talentPayload `json:"talent,omitempty"`
+	FromStage  string         `json:"from_stage,omitempty"`
+	ToStage    string         `json:"to_stage,omitempty"`
+	Task       *taskPayload   `json:"task,omitempty"`
+	Digest     *digestPayload `json:"digest,omitempty"`
+}
+
+type taskPayload struct {
+	Id       string    `json:"id"`
+	TalentId string    `json:"talent_id"`
+	Assignee string    `json:"assignee"`
+	Note     string    `json:"note"`
+	DueAt    time.Time `json:"due_at"`
+	Overdue  bool      `json:"overdue"`
+}
+
+type digestPayload struct {
+	Assignee string        `json:"assignee"`
+	Day      string        `json:"day"`
+	Overdue  []taskPayload `json:"overdue"`
+	DueToday []taskPayload `json:"due_today"`
+}
+
+func newTaskPayload(task domain.FollowUpTask) taskPayload {
+	return taskPayload{
+		Id:       task.Id.String(),
+		TalentId: task.TalentId,
+		Assignee: task.Assignee,
+		Note:     task.Note,
+		DueAt:    task.DueAt,
+		Overdue:  task.Overdue,
+	}
+}
+
+func newTaskPayloads(tasks []domain.FollowUpTask) []taskPayload {
+	payloads := make([]taskPayload, 0, len(tasks))
+	for _, task := range tasks {
+		payloads = append(payloads, newTaskPayload(task))
+	}
+	return payloads
+}
+
+type talentPayload struct {
+	Id             string          `json:"id"`
+	ProfileURL     string          `json:"profile_url"`
+	PossibleRole   string          `json:"possible_role"`
+	FullName       string          `json:"full_name"`
+	Headline       string          `json:"headline"`
+	CurrentCompany string          `json:"current_company"`
+	CurrentRole    string          `json:"current_role"`
+	Tags           []string        `json:"tags"`
+	Notes          string          `json:"notes"`
+	CapturedAt     time.Time       `json:"captured_at"`
+	Role           rolePayload     `json:"role"`
+	Location       locationPayload `json:"location"`
+	Stage          string          `json:"stage"`
+	Archived       bool            `json:"archived"`
+	Version        int64           `json:"version"`
+}
+
+type rolePayload struct {
+	Function   string `json:"function,omitempty"`
+	Seniority  string `json:"seniority,omitempty"`
+	Management bool   `json:"management"`
+}
+
+func newRolePayload(role domain.RoleProfile) rolePayload {
+	return rolePayload{
+		Function:   role.Function,
+		Seniority:  role.Seniority,
+		Management: role.Management,
+	}
+}
+
+type locationPayload struct {
+	City             string `json:"city,omitempty"`
+	State            string `json:"state,omitempty"`
+	Country          string `json:"country,omitempty"`
+	Timezone         string `json:"timezone,omitempty"`
+	WorkModel        string `json:"work_model,omitempty"`
+	OpenToRelocation bool   `json:"open_to_relocation"`
+}
+
+func newLocationPayload(location domain.Location) locationPayload {
+	return locationPayload{
+		City:             location.City,
+		State:            location.State,
+		Country:          location.Country,
+		Timezone:         location.Timezone,
+		WorkModel:        location.WorkModel,
+		OpenToRelocation: location.OpenToRelocation,
+	}
+}
+
+func (d *Dispatcher) Handle(ctx context.Context, event domain.TalentEvent) error {
+	subscriptions, err := d.gateway.GetSubscriptions(ctx)
+	if err != nil {
+		return fmt.Errorf("could not load webhook subscriptions: %w", err)
+	}
+
+	payload := eventPayload{
+		Id:         event.Id,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+		TalentId:   event.TalentId,
+		FromStage:  event.FromStage,
+		ToStage:    event.ToStage,
+	}
+	if t := event.Talent; t != nil {
+		payload.Talent = &talentPayload{
+			Id:             t.Id.String(),
+			ProfileURL:     t.ProfileURL,
+			PossibleRole:   t.PossibleRole,
+			FullName:       t.FullName,
+			Headline:       t.Headline,
+			CurrentCompany: t.CurrentCompany,
+			CurrentRole:    t.CurrentRole,
+			Tags:           t.Tags,
+			Notes:          t.Notes,
+			CapturedAt:     t.CapturedAt,
+			Role:           newRolePayload(t.NormalizedRole()),
+			Location:       newLocationPayload(t.Location),
+			Stage:          t.CurrentStage(),
+			Archived:       t.IsArchived(),
+			Version:        t.Version,
+		}
+	}
+	if event.Task != nil {
+		task := newTaskPayload(*event.Task)
+		payload.Task = &task
+	}
+	if digest := event.Digest; digest != nil {
+		payload.Digest = &digestPayload{
+			Assignee: digest.Assignee,
+			Day:      digest.Day,
+			Overdue:  newTaskPayloads(digest.Overdue),
+			DueToday: newTaskPayloads(digest.DueToday),
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	// a delivery that could not be stored fails the event, so the relay
+	// keeps it in the outbox; receivers of the subscriptions already stored
+	// get it twice, as with any redelivery
+	var errs []error
+	for _, subscription := range subscriptions {
+		if !subscription.Accepts(event.Type) {
+			continue
+		}
+		delivery := domain.NewWebhookDelivery(subscription.Id.String(), event.Id, event.Type, body)
+		err := d.gateway.SaveDelivery(ctx, *delivery)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("could not store webhook delivery for subscription %s: %w", subscription.Id.String(), err))
+			continue
+		}
+		d.Enqueue(*delivery)
+	}
+	return errors.Join(errs...)
+}
+
+// Enqueue never blocks and ignores deliveries already held by the
+// dispatcher. When the queue is full the delivery stays pending in storage
+// and is picked up by the next rescan.
+func (d *Dispatcher) Enqueue(delivery domain.WebhookDelivery) {
+	if d.track(delivery.Id.String()) {
+		d.push(delivery)
+	}
+}
+
+func (d *Dispatcher) push(delivery domain.WebhookDelivery) {
+	select {
+	case d.queue <- delivery:
+	default:
+		d.release(delivery.Id.String())
+		slog.Warn("webhook queue full, delivery left pending", "delivery_id", delivery.Id.String())
+	}
+}
+
+func (d *Dispatcher) track(id string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.tracked[id] {
+		return false
+	}
+	d.tracked[id] = true
+	return true
+}
+
+func (d *Dispatcher) release(id string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.tracked, id)
+}
+
+// Start runs the workers and rescans the due deliveries every interval
+// until ctx is done. Wait blocks until they have stopped.
+func (d *Dispatcher) Start(ctx context.Context) {
+	for i := 0; i < d.workers; i++ {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.work(ctx)
+		}()
+	}
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.rescan(ctx)
+	}()
+}
+
+func (d *Dispatcher) rescan(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		d.resume(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) resume(ctx context.Context) {
+	if len(d.queue) == cap(d.queue) {
+		return
+	}
+	due, err := d.gateway.GetDueDeliveries(ctx, time.Now().UTC(), cap(d.queue))
+	if err != nil {
+		slog.Error("webhook error: could not resume pending deliveries", "error", err)
+		return
+	}
+	for _, delivery := range due {
+		d.Enqueue(delivery)
+	}
+}
+
+func (d *Dispatcher) Wait() {
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case delivery := <-d.queue:
+			if !d.attempt(ctx, delivery) {
+				d.release(delivery.Id.String())
+			}
+		}
+	}
+}
+
+// attempt sends the delivery once and reports whether a retry was
+// scheduled.
+func (d *Dispatcher) attempt(ctx context.Context, delivery domain.WebhookDelivery) bool {
+	logger := slog.Default().With("delivery_id", delivery.Id.String(), "subscription_id", delivery.SubscriptionId)
+
+	subscription, err := d.gateway.GetSubscriptionById(ctx, delivery.SubscriptionId)
+	if errors.Is(err, domain.ErrWebhookNotFound) {
+		delivery.RecordAttempt(0, errors.New("subscription was deleted"), 0, time.Time{})
+		d.save(ctx, logger, delivery)
+		return false
+	}
+	if err != nil {
+		logger.Error("webhook error: could not load subscription", "error", err)
+		d.scheduleAt(ctx, delivery, time.Now().Add(d.initialBackoff))
+		return true
+	}
+
+	status, err := d.send(ctx, subscription, delivery)
+	if ctx.Err() != nil {
+		// shutting down, the delivery is still pending in storage
+		return false
+	}
+	delivery.RecordAttempt(status, err, d.maxAttempts, time.Now().UTC().Add(d.backoff(delivery.Attempts+1)))
+	d.save(ctx, logger, delivery)
+
+	switch delivery.Status {
+	case domain.DeliveryDelivered:
+		logger.Info("webhook delivered", "attempts", delivery.Attempts, "response_status", status)
+	case domain.DeliveryDead:
+		logger.Warn("webhook moved to dead letter", "attempts", delivery.Attempts, "error", delivery.LastError)
+	default:
+		logger.Info("webhook attempt failed, retrying", "attempts", delivery.Attempts, "next_attempt_at", delivery.NextAttemptAt, "error", delivery.LastError)
+		d.scheduleAt(ctx, delivery, delivery.NextAttemptAt)
+		return true
+	}
+	return false
+}
+
+func (d *Dispatcher) send(ctx context.Context, subscription *domain.WebhookSubscription, delivery domain.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "talent-db-webhooks")
+	req.Header.Set("X-Webhook-Id", delivery.Id.String())
+	req.Header.Set("X-Webhook-Event", delivery.EventType)
+	req.Header.Set(SignatureHeader, Sign(subscription.Secret, time.Now(), delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("receiver answered %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+func (d *Dispatcher) save(ctx context.Context, logger *slog.Logger, delivery domain.WebhookDelivery) {
+	err := d.gateway.SaveDelivery(ctx, delivery)
+	if err != nil {
+		logger.Error("webhook error: could not store delivery", "error", err)
+	}
+}
+
+// scheduleAt queues a delivery the dispatcher already holds once at is
+// reached. A retry that finds the queue full is left to the rescan.
+func (d *Dispatcher) scheduleAt(ctx context.Context, delivery domain.WebhookDelivery, at time.Time) {
+	wait := time.Until(at)
+	if wait <= 0 {
+		d.push(delivery)
+		return
+	}
+	time.AfterFunc(wait, func() {
+		if ctx.Err() == nil {
+			d.push(delivery)
+		}
+	})
+}
+
+// backoff doubles the wait for every attempt already made, up to maxBackoff.
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	wait := d.initialBackoff
+	for i := 1; i < attempt && wait < d.maxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, d.maxBackoff)
+}
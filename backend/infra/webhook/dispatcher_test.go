@@ -0,0 +1,283 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/infra/config"
+)
+
+type InMemoryWebhookGateway struct {
+	mu            sync.Mutex
+	subscriptions map[string]domain.WebhookSubscription
+	deliveries    map[string]domain.WebhookDelivery
+	saveErr       error
+}
+
+func NewInMemoryWebhookGateway() *InMemoryWebhookGateway {
+	return &InMemoryWebhookGateway{
+		subscriptions: make(map[string]domain.WebhookSubscription),
+		deliveries:    make(map[string]domain.WebhookDelivery),
+	}
+}
+
+func (g *InMemoryWebhookGateway) SaveSubscription(ctx context.Context, subscription domain.WebhookSubscription) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.subscriptions[subscription.Id.String()] = subscription
+	return nil
+}
+func (g *InMemoryWebhookGateway) GetSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var subscriptions []domain.WebhookSubscription
+	for _, s := range g.subscriptions {
+		subscriptions = append(subscriptions, s)
+	}
+	return subscriptions, nil
+}
+func (g *InMemoryWebhookGateway) GetSubscriptionById(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if subscription, exists := g.subscriptions[id]; exists {
+		return &subscription, nil
+	}
+	return nil, domain.ErrWebhookNotFound
+}
+func (g *InMemoryWebhookGateway) DeleteSubscription(ctx context.Context, id string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.subscriptions, id)
+	return nil
+}
+func (g *InMemoryWebhookGateway) SaveDelivery(ctx context.Context, delivery domain.WebhookDelivery) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.saveErr != nil {
+		return g.saveErr
+	}
+	g.deliveries[delivery.Id.String()] = delivery
+	return nil
+}
+func (g *InMemoryWebhookGateway) GetDeliveryById(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if delivery, exists := g.deliveries[id]; exists {
+		return &delivery, nil
+	}
+	return nil, domain.ErrWebhookDeliveryNotFound
+}
+func (g *InMemoryWebhookGateway) GetDeliveries(ctx context.Context, subscriptionId string, status string, limit int) ([]domain.WebhookDelivery, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var deliveries []domain.WebhookDelivery
+	for _, d := range g.deliveries {
+		if (subscriptionId == "" || d.SubscriptionId == subscriptionId) && (status == "" || d.Status == status) {
+			deliveries = append(deliveries, d)
+		}
+	}
+	return deliveries, nil
+}
+
+func (g *InMemoryWebhookGateway) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var deliveries []domain.WebhookDelivery
+	for _, d := range g.deliveries {
+		if d.Status == domain.DeliveryPending && !d.NextAttemptAt.After(now) {
+			deliveries = append(deliveries, d)
+		}
+	}
+	slices.SortFunc(deliveries, func(a, b domain.WebhookDelivery) int {
+		return a.NextAttemptAt.Compare(b.NextAttemptAt)
+	})
+	return deliveries[:min(limit, len(deliveries))], nil
+}
+
+func testWebhookConfig() config.WebhookConfig {
+	cfg := config.Default().Webhook
+	cfg.Workers = 1
+	cfg.MaxAttempts = 3
+	cfg.InitialBackoff = 10 * time.Millisecond
+	cfg.MaxBackoff = 20 * time.Millisecond
+	cfg.Timeout = time.Second
+	return cfg
+}
+
+func startDispatcher(t *testing.T, gateway *InMemoryWebhookGateway, url string, events []string) (*Dispatcher, *domain.WebhookSubscription) {
+	subscription, err := domain.NewWebhookSubscription(url, "", events)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_ = gateway.SaveSubscription(context.Background(), *subscription)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dispatcher := NewDispatcher(testWebhookConfig(), gateway)
+	dispatcher.Start(ctx)
+	t.Cleanup(func() {
+		cancel()
+		dispatcher.Wait()
+	})
+	return dispatcher, subscription
+}
+
+func waitForStatus(t *testing.T, gateway *InMemoryWebhookGateway, status string) domain.WebhookDelivery {
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		deliveries, _ := gateway.GetDeliveries(context.Background(), "", status, 10)
+		if len(deliveries) > 0 {
+			return deliveries[0]
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("no delivery reached status %s", status)
+	return domain.WebhookDelivery{}
+}
+
+func TestDispatcherSendsSignedPayload(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer receiver.Close()
+
+	gateway := NewInMemoryWebhookGateway()
+	dispatcher, subscription := startDispatcher(t, gateway, receiver.URL, []string{domain.EventTalentCreated})
+
+	talent, _ := domain.Create("https://linkedin.com/in/test", "Backend Engineer", "John Doe", "Senior Developer", "", "", nil, "")
+	_ = dispatcher.Handle(context.Background(), domain.NewTalentEvent(domain.EventTalentCreated, talent.Id.String(), talent))
+	_ = dispatcher.Handle(context.Background(), domain.NewTalentEvent(domain.EventTalentDeleted, talent.Id.String(), nil))
+
+	r := <-received
+	body := <-bodies
+	if !Verify(subscription.Secret, r.Header.Get(SignatureHeader), body, time.Minute, time.Now()) {
+		t.Errorf("signature %q does not match body", r.Header.Get(SignatureHeader))
+	}
+	if r.Header.Get("X-Webhook-Event") != domain.EventTalentCreated {
+		t.Errorf("expected event header talent.created, got %s", r.Header.Get("X-Webhook-Event"))
+	}
+
+	var payload eventPayload
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.TalentId != talent.Id.String() || payload.Talent == nil || payload.Talent.FullName != "John Doe" {
+		t.Errorf("unexpected payload %s", body)
+	}
+
+	delivered := waitForStatus(t, gateway, domain.DeliveryDelivered)
+	if delivered.Attempts != 1 || delivered.ResponseStatus != http.StatusOK {
+		t.Errorf("unexpected delivery %+v", delivered)
+	}
+	if deliveries, _ := gateway.GetDeliveries(context.Background(), "", "", 10); len(deliveries) != 1 {
+		t.Errorf("expected the filtered event to create no delivery, got %d deliveries", len(deliveries))
+	}
+}
+
+func TestDispatcherRetriesUntilSuccess(t *testing.T) {
+	var calls atomic.Int32
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer receiver.Close()
+
+	gateway := NewInMemoryWebhookGateway()
+	dispatcher, _ := startDispatcher(t, gateway, receiver.URL, []string{"*"})
+	_ = dispatcher.Handle(context.Background(), domain.NewTalentEvent(domain.EventTalentDeleted, "talent-1", nil))
+
+	delivered := waitForStatus(t, gateway, domain.DeliveryDelivered)
+	if delivered.Attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", delivered.Attempts)
+	}
+}
+
+func TestDispatcherMovesToDeadLetter(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	gateway := NewInMemoryWebhookGateway()
+	dispatcher, _ := startDispatcher(t, gateway, receiver.URL, []string{"*"})
+	_ = dispatcher.Handle(context.Background(), domain.NewTalentEvent(domain.EventTalentDeleted, "talent-1", nil))
+
+	dead := waitForStatus(t, gateway, domain.DeliveryDead)
+	if dead.Attempts != 3 || dead.ResponseStatus != http.StatusServiceUnavailable || dead.LastError == "" {
+		t.Errorf("unexpected dead delivery %+v", dead)
+	}
+}
+
+func TestDispatcherBackoff(t *testing.T) {
+	dispatcher := NewDispatcher(config.WebhookConfig{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}, nil)
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+	for i, want := range expected {
+		if got := dispatcher.backoff(i + 1); got != want {
+			t.Errorf("attempt %d: expected %v, got %v", i+1, want, got)
+		}
+	}
+}
+
+func TestDispatcherRescansDeliveriesLeftPending(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(5 * time.Millisecond)
+	}))
+	defer receiver.Close()
+
+	gateway := NewInMemoryWebhookGateway()
+	subscription, _ := domain.NewWebhookSubscription(receiver.URL, "", []string{"*"})
+	_ = gateway.SaveSubscription(context.Background(), *subscription)
+	cfg := testWebhookConfig()
+	cfg.QueueSize = 1
+	cfg.RescanInterval = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	dispatcher := NewDispatcher(cfg, gateway)
+	dispatcher.Start(ctx)
+	defer func() {
+		cancel()
+		dispatcher.Wait()
+	}()
+
+	for range 5 {
+		_ = dispatcher.Handle(context.Background(), domain.NewTalentEvent(domain.EventTalentDeleted, "talent-1", nil))
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if delivered, _ := gateway.GetDeliveries(context.Background(), "", domain.DeliveryDelivered, 10); len(delivered) == 5 {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	pending, _ := gateway.GetDeliveries(context.Background(), "", domain.DeliveryPending, 10)
+	t.Errorf("expected every delivery to be sent, %d left pending", len(pending))
+}
+
+func TestDispatcherHandleFailsWhenDeliveryIsNotStored(t *testing.T) {
+	gateway := NewInMemoryWebhookGateway()
+	subscription, _ := domain.NewWebhookSubscription("https://example.com/hook", "", []string{"*"})
+	_ = gateway.SaveSubscription(context.Background(), *subscription)
+	gateway.saveErr = errors.New("unavailable")
+
+	err := NewDispatcher(testWebhookConfig(), gateway).Handle(context.Background(), domain.NewTalentEvent(domain.EventTalentDeleted, "talent-1", nil))
+
+	if err == nil {
+		t.Error("expected the event to fail so the outbox keeps it")
+	}
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const SignatureHeader = "X-Webhook-Signature"
+
+// Sign returns the value of the signature header: the unix timestamp and the
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the subscription secret.
+// Receivers recompute it and should reject old timestamps to avoid replays.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + ts + ",v1=" + computeSignature(secret, ts, body)
+}
+
+// Verify checks a signature header produced by Sign.
+func Verify(secret string, header string, body []byte, tolerance time.Duration, now time.Time) bool {
+	var ts, signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			signature = value
+		}
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || signature == "" {
+		return false
+	}
+	age := now.Sub(time.Unix(unix, 0))
+	if age > tolerance || age < -tolerance {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(computeSignature(secret, ts, body)))
+}
+
+func computeSignature(secret string, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,200 @@
+package webserver
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+// multipartOverhead is the room left for the multipart boundaries, headers
+// and form fields around the file.
+const multipartOverhead = 64 << 10
+
+// UploadAttachment godoc
+// @Summary Anexa um arquivo a um talento
+// @Description Recebe um arquivo via multipart/form-data no campo file. O tipo é detectado pelo conteúdo (PDF, DOCX, texto, PNG ou JPEG) e arquivos iguais são armazenados uma única vez. O texto de PDF, DOCX e texto é extraído em segundo plano, acompanhe pelo campo text.status.
+// @Tags attachments
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "ID do talento"
+// @Param file formData file true "Arquivo"
+// @Param kind formData string false "Tipo do anexo (cv, portfolio, other), padrão other"
+// @Success 201 {object} usecase.AttachmentOutputDTO
+// @Header 201 {string} Location "URL para baixar o anexo"
+// @Failure 400 {string} string "bad request"
+// @Failure 404 {string} string "talent not found"
+// @Failure 413 {string} string "file too large"
+// @Failure 415 {string} string "unsupported file type"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /talent/{id}/attachments [post]
+func (h *Handler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	// the limit of the route already bounds the body, so the whole form fits
+	// in memory
+	err := r.ParseMultipartForm(h.maxAttachmentBytes + multipartOverhead)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("the file field is required"))
+		return
+	}
+	defer file.Close()
+
+	uc := usecase.NewUploadAttachmentUseCase(r.Context(), h.TalentGateway, h.AttachmentGateway, h.BlobStore, h.ExtractionQueue, h.maxAttachmentBytes)
+	output, err := uc.Execute(usecase.UploadAttachmentInputDTO{
+		TalentId: r.PathValue("id"),
+		Kind:     r.FormValue("kind"),
+		FileName: header.Filename,
+		Content:  file,
+	})
+	if err != nil {
+		writeAttachmentError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Add("Location", "/talent/"+output.TalentId+"/attachments/"+output.Id)
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// ListAttachments godoc
+// @Summary Lista os anexos de um talento
+// @Description Retorna os metadados dos anexos, do mais recente para o mais antigo.
+// @Tags attachments
+// @Produce json
+// @Param id path string true "ID do talento"
+// @Success 200 {object} usecase.ListAttachmentsOutputDTO
+// @Failure 404 {string} string "talent not found"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /talent/{id}/attachments [get]
+func (h *Handler) ListAttachments(w http.ResponseWriter, r *http.Request) {
+	uc := usecase.NewListAttachmentsUseCase(r.Context(), h.TalentGateway, h.AttachmentGateway)
+	output, err := uc.Execute(usecase.ListAttachmentsInputDTO{TalentId: r.PathValue("id")})
+	if err != nil {
+		writeAttachmentError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// DownloadAttachment godoc
+// @Summary Baixa um anexo
+// @Description Retorna o conteúdo do anexo com o tipo detectado no upload. O ETag é o SHA-256 do conteúdo.
+// @Tags attachments
+// @Produce octet-stream
+// @Param id path string true "ID do talento"
+// @Param attachmentId path string true "ID do anexo"
+// @Param If-None-Match header string false "ETag já conhecido pelo cliente"
+// @Success 200 {file} file "conteúdo do anexo"
+// @Header 200 {string} ETag "SHA-256 do conteúdo"
+// @Header 200 {string} Content-Disposition "Nome original do arquivo"
+// @Success 304 {string} string "not modified"
+// @Failure 404 {string} string "attachment not found"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /talent/{id}/attachments/{attachmentId} [get]
+func (h *Handler) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	uc := usecase.NewDownloadAttachmentUseCase(r.Context(), h.AttachmentGateway, h.BlobStore)
+	output, err := uc.Execute(usecase.DownloadAttachmentInputDTO{
+		TalentId: r.PathValue("id"),
+		Id:       r.PathValue("attachmentId"),
+	})
+	if err != nil {
+		writeAttachmentError(w, r, err)
+		return
+	}
+	defer output.Content.Close()
+
+	attachment := output.Attachment
+	etag := `"` + attachment.SHA256 + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "private, max-age=3600")
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, err = io.Copy(w, output.Content)
+	if err != nil {
+		logging.FromContext(r.Context()).Warn("attachment download interrupted", "attachment_id", attachment.Id, "error", err)
+	}
+}
+
+// GetAttachmentText godoc
+// @Summary Retorna o texto extraído de um anexo
+// @Description Disponível quando text.status do anexo é done. O texto alimenta a busca de talentos e as sugestões de tags.
+// @Tags attachments
+// @Produce plain
+// @Param id path string true "ID do talento"
+// @Param attachmentId path string true "ID do anexo"
+// @Success 200 {string} string "texto extraído"
+// @Failure 404 {string} string "attachment not found"
+// @Failure 409 {string} string "text not available yet or extraction failed"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /talent/{id}/attachments/{attachmentId}/text [get]
+func (h *Handler) GetAttachmentText(w http.ResponseWriter, r *http.Request) {
+	uc := usecase.NewGetAttachmentTextUseCase(r.Context(), h.AttachmentGateway, h.BlobStore)
+	output, err := uc.Execute(usecase.GetAttachmentTextInputDTO{
+		TalentId: r.PathValue("id"),
+		Id:       r.PathValue("attachmentId"),
+	})
+	if err != nil {
+		writeAttachmentError(w, r, err)
+		return
+	}
+	defer output.Text.Close()
+
+	w.Header().Set("Content-Type", domain.ContentTypeText)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, err = io.Copy(w, output.Text)
+	if err != nil {
+		logging.FromContext(r.Context()).Warn("attachment text download interrupted", "attachment_id", r.PathValue("attachmentId"), "error", err)
+	}
+}
+
+func writeAttachmentError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrTalentNotFound), errors.Is(err, domain.ErrAttachmentNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Is(err, domain.ErrAttachmentTooLarge):
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrUnsupportedAttachment):
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrTextNotReady):
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrInvalidAttachment):
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logging.FromContext(r.Context()).Error("application error", "error", err)
+	}
+}
@@ -0,0 +1,156 @@
+package webserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/extraction"
+	"github.com/allanCordeiro/talent-db/application/usecase"
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+func uploadRequest(path string, fileName string, content []byte, kind string) *http.Request {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	if kind != "" {
+		_ = form.WriteField("kind", kind)
+	}
+	part, _ := form.CreateFormFile("file", fileName)
+	_, _ = part.Write(content)
+	_ = form.Close()
+
+	req := httptest.NewRequest(http.MethodPost, path, &body)
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	return req
+}
+
+func TestAttachments(t *testing.T) {
+	cfg := testConfig()
+	cfg.Attachments.MaxBytes = 1024
+	server := NewServer(cfg, metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+	send := func(req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := send(adminRequest(http.MethodPost, "/talent", talentBody, "token"))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+	path := rec.Header().Get("Location") + "/attachments"
+
+	pdf := []byte("%PDF-1.7\n1 0 obj\n<<>>\nendobj\n")
+	rec = send(uploadRequest(path, `C:\cvs\ana "final".pdf`, pdf, "cv"))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body)
+	}
+	var uploaded usecase.AttachmentOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&uploaded)
+	if uploaded.ContentType != "application/pdf" || uploaded.FileName != "ana final.pdf" || uploaded.Kind != "cv" {
+		t.Errorf("unexpected attachment %+v", uploaded)
+	}
+	if location := rec.Header().Get("Location"); location != path+"/"+uploaded.Id {
+		t.Errorf("unexpected location %q", location)
+	}
+
+	rec = send(adminRequest(http.MethodGet, path+"/"+uploaded.Id, "", "token"))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if !bytes.Equal(rec.Body.Bytes(), pdf) {
+		t.Errorf("downloaded content differs from the upload")
+	}
+	if disposition := rec.Header().Get("Content-Disposition"); disposition != `attachment; filename="ana final.pdf"` {
+		t.Errorf("unexpected content disposition %q", disposition)
+	}
+	if rec.Header().Get("Content-Type") != "application/pdf" || rec.Header().Get("X-Content-Type-Options") != "nosniff" {
+		t.Errorf("unexpected headers %v", rec.Header())
+	}
+
+	req := adminRequest(http.MethodGet, path+"/"+uploaded.Id, "", "token")
+	req.Header.Set("If-None-Match", rec.Header().Get("ETag"))
+	if rec = send(req); rec.Code != http.StatusNotModified {
+		t.Errorf("expected 304 for a known ETag, got %d", rec.Code)
+	}
+
+	rec = send(adminRequest(http.MethodGet, path, "", "token"))
+	var list usecase.ListAttachmentsOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&list)
+	if len(list.Attachments) != 1 || list.Attachments[0].Id != uploaded.Id {
+		t.Errorf("unexpected list %+v", list)
+	}
+
+	if rec = send(uploadRequest(path, "tool.exe", []byte("MZ\x90\x00\x03\x00\x00\x00"), "")); rec.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("expected 415 for an executable, got %d", rec.Code)
+	}
+	if rec = send(uploadRequest(path, "big.txt", []byte(strings.Repeat("a", 2048)), "")); rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected 413 above the limit, got %d", rec.Code)
+	}
+	if rec = send(uploadRequest("/talent/missing/attachments", "cv.pdf", pdf, "")); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown talent, got %d", rec.Code)
+	}
+	if rec = send(adminRequest(http.MethodGet, "/talent/missing/attachments/"+uploaded.Id, "", "token")); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an attachment of another talent, got %d", rec.Code)
+	}
+}
+
+func TestAttachmentTextFeedsSearch(t *testing.T) {
+	talents := NewInMemoryTalentGateway()
+	deps := testDependencies(talents, NewInMemoryIdempotencyGateway())
+	queue := deps.ExtractionQueue.(*RecordingExtractionQueue)
+	worker := extraction.NewWorker(deps.AttachmentGateway, deps.BlobStore, talents, deps.TagSuggester, domain.SystemClock{}, 1, 10, time.Minute)
+	server := NewServer(testConfig(), metrics.New(), deps)
+	send := func(req *http.Request) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := send(adminRequest(http.MethodPost, "/talent", talentBody, "token"))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+	path := rec.Header().Get("Location") + "/attachments"
+
+	rec = send(uploadRequest(path, "cv.txt", []byte("Experiência com Apache Airflow e dbt"), "cv"))
+	var uploaded usecase.AttachmentOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&uploaded)
+	if uploaded.Text.Status != "pending" || len(queue.attachments) != 1 {
+		t.Fatalf("expected the text extraction to be queued, got %+v", uploaded.Text)
+	}
+	if rec = send(adminRequest(http.MethodGet, path+"/"+uploaded.Id+"/text", "", "token")); rec.Code != http.StatusConflict {
+		t.Errorf("expected 409 while the extraction is pending, got %d", rec.Code)
+	}
+
+	worker.Process(context.Background(), queue.attachments[0])
+
+	rec = send(adminRequest(http.MethodGet, path+"/"+uploaded.Id+"/text", "", "token"))
+	if rec.Code != http.StatusOK || rec.Body.String() != "Experiência com Apache Airflow e dbt" {
+		t.Errorf("expected the extracted text, got %d: %q", rec.Code, rec.Body)
+	}
+	rec = send(adminRequest(http.MethodGet, path, "", "token"))
+	var list usecase.ListAttachmentsOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&list)
+	if len(list.Attachments) != 1 || list.Attachments[0].Text.Status != "done" || list.Attachments[0].Text.ExtractedAt == nil {
+		t.Errorf("expected the extraction to be done, got %+v", list.Attachments)
+	}
+
+	for query, want := range map[string]int{"airflow": 1, "airfl%20dbt": 1, "spark": 0} {
+		rec = send(adminRequest(http.MethodGet, "/talents?q="+query, "", "token"))
+		var talents usecase.ListTalentsOutputDTO
+		_ = json.NewDecoder(rec.Body).Decode(&talents)
+		if len(talents.Talents) != want {
+			t.Errorf("%s: expected %d talents, got %d", query, want, len(talents.Talents))
+		}
+	}
+}
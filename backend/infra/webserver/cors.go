@@ -0,0 +1,86 @@
+package webserver
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/allanCordeiro/talent-db/infra/config"
+)
+
+type corsPolicy struct {
+	allowedOrigins   []string
+	allowAnyOrigin   bool
+	allowedMethods   string
+	allowedHeaders   string
+	exposedHeaders   string
+	allowCredentials bool
+	maxAge           string
+}
+
+func newCORSPolicy(cfg config.CORSConfig) *corsPolicy {
+	return &corsPolicy{
+		allowedOrigins:   cfg.AllowedOrigins,
+		allowAnyOrigin:   slices.Contains(cfg.AllowedOrigins, "*"),
+		allowedMethods:   strings.Join(cfg.AllowedMethods, ", "),
+		allowedHeaders:   strings.Join(cfg.AllowedHeaders, ", "),
+		exposedHeaders:   strings.Join(cfg.ExposedHeaders, ", "),
+		allowCredentials: cfg.AllowCredentials,
+		maxAge:           strconv.Itoa(int(cfg.MaxAge.Seconds())),
+	}
+}
+
+func (p *corsPolicy) allows(origin string) bool {
+	return p.allowAnyOrigin || slices.Contains(p.allowedOrigins, origin)
+}
+
+// withCORS answers preflight requests itself, so they never reach withAuth,
+// and decorates the actual responses for allowed origins.
+func withCORS(policy *corsPolicy, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+		if preflight {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+		}
+
+		if !policy.allows(origin) {
+			if preflight {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if policy.allowAnyOrigin && !policy.allowCredentials {
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		} else {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+		if policy.allowCredentials {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		if preflight {
+			w.Header().Set("Access-Control-Allow-Methods", policy.allowedMethods)
+			w.Header().Set("Access-Control-Allow-Headers", policy.allowedHeaders)
+			w.Header().Set("Access-Control-Max-Age", policy.maxAge)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		if policy.exposedHeaders != "" {
+			w.Header().Set("Access-Control-Expose-Headers", policy.exposedHeaders)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
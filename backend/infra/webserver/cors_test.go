@@ -0,0 +1,85 @@
+package webserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+const extensionOrigin = "chrome-extension://abcdefghijklmnopabcdefghijklmnop"
+
+func newCORSTestServer() *Server {
+	cfg := testConfig()
+	cfg.CORS.AllowedOrigins = []string{extensionOrigin}
+	return NewServer(cfg, metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+}
+
+func TestPreflightSkipsAuth(t *testing.T) {
+	server := newCORSTestServer()
+
+	req := httptest.NewRequest(http.MethodOptions, "/talent", nil)
+	req.Header.Set("Origin", extensionOrigin)
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	req.Header.Set("Access-Control-Request-Headers", "authorization, content-type")
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rec.Code)
+	}
+
+	if rec.Header().Get("Access-Control-Allow-Origin") != extensionOrigin {
+		t.Errorf("expected allowed origin %s, got %s", extensionOrigin, rec.Header().Get("Access-Control-Allow-Origin"))
+	}
+
+	if !strings.Contains(rec.Header().Get("Access-Control-Allow-Headers"), "Authorization") {
+		t.Errorf("expected Authorization to be allowed, got %s", rec.Header().Get("Access-Control-Allow-Headers"))
+	}
+
+	if rec.Header().Get("Access-Control-Max-Age") != "600" {
+		t.Errorf("expected max age 600, got %s", rec.Header().Get("Access-Control-Max-Age"))
+	}
+}
+
+func TestPreflightFromUnknownOrigin(t *testing.T) {
+	server := newCORSTestServer()
+
+	req := httptest.NewRequest(http.MethodOptions, "/talent", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403, got %d", rec.Code)
+	}
+
+	if rec.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Error("expected no Access-Control-Allow-Origin header")
+	}
+}
+
+func TestCORSHeadersOnActualRequest(t *testing.T) {
+	server := newCORSTestServer()
+
+	req := httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(talentBody))
+	req.Header.Set("Origin", extensionOrigin)
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+
+	if rec.Header().Get("Access-Control-Allow-Origin") != extensionOrigin {
+		t.Errorf("expected allowed origin %s, got %s", extensionOrigin, rec.Header().Get("Access-Control-Allow-Origin"))
+	}
+
+	if !strings.Contains(rec.Header().Get("Access-Control-Expose-Headers"), "Location") {
+		t.Errorf("expected Location to be exposed, got %s", rec.Header().Get("Access-Control-Expose-Headers"))
+	}
+}
@@ -0,0 +1,59 @@
+package webserver
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func formatETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+func parseETag(value string) (int64, bool) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+	if len(value) < 2 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return 0, false
+	}
+	version, err := strconv.ParseInt(value[1:len(value)-1], 10, 64)
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+	return version, true
+}
+
+// matchesETag reports whether any of the comma separated tags in an
+// If-None-Match header refers to version.
+func matchesETag(header string, version int64) bool {
+	for _, tag := range strings.Split(header, ",") {
+		if strings.TrimSpace(tag) == "*" {
+			return true
+		}
+		if v, ok := parseETag(tag); ok && v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// requireIfMatch extracts the expected version from If-Match, answering 428
+// when the header is missing and 412 when it is not a version we issued.
+// If-Match uses the strong comparison (RFC 7232 section 3.1), so weak tags
+// never match.
+func requireIfMatch(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" {
+		w.WriteHeader(http.StatusPreconditionRequired)
+		return 0, false
+	}
+	if strings.HasPrefix(header, "W/") {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return 0, false
+	}
+	version, ok := parseETag(header)
+	if !ok {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return 0, false
+	}
+	return version, true
+}
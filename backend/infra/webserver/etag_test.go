@@ -0,0 +1,279 @@
+package webserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+func newTestHandlerWithTalent(t *testing.T) (*Handler, string) {
+	handler := NewHandler(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+	rec := httptest.NewRecorder()
+	handler.CreateTalent(rec, httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(talentBody)))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+	return handler, strings.TrimPrefix(rec.Header().Get("Location"), "/talent/")
+}
+
+func talentRequest(method string, id string, body string) *http.Request {
+	req := httptest.NewRequest(method, "/talent/"+id, strings.NewReader(body))
+	req.SetPathValue("id", id)
+	return req
+}
+
+func TestGetTalentReturnsETag(t *testing.T) {
+	handler, id := newTestHandlerWithTalent(t)
+
+	rec := httptest.NewRecorder()
+	handler.GetTalent(rec, talentRequest(http.MethodGet, id, ""))
+	if rec.Header().Get("ETag") != `"1"` {
+		t.Fatalf("expected ETag \"1\", got %s", rec.Header().Get("ETag"))
+	}
+
+	req := talentRequest(http.MethodGet, id, "")
+	req.Header.Set("If-None-Match", `"1"`)
+	rec = httptest.NewRecorder()
+	handler.GetTalent(rec, req)
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("expected 304, got %d", rec.Code)
+	}
+}
+
+func TestUpdateTalentRequiresIfMatch(t *testing.T) {
+	handler, id := newTestHandlerWithTalent(t)
+
+	rec := httptest.NewRecorder()
+	handler.UpdateTalent(rec, talentRequest(http.MethodPut, id, talentBody))
+	if rec.Code != http.StatusPreconditionRequired {
+		t.Errorf("expected 428, got %d", rec.Code)
+	}
+}
+
+func TestUpdateTalentWithStaleETag(t *testing.T) {
+	handler, id := newTestHandlerWithTalent(t)
+
+	req := talentRequest(http.MethodPut, id, talentBody)
+	req.Header.Set("If-Match", `"1"`)
+	rec := httptest.NewRecorder()
+	handler.UpdateTalent(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rec.Code)
+	}
+	if rec.Header().Get("ETag") != `"2"` {
+		t.Errorf("expected ETag \"2\", got %s", rec.Header().Get("ETag"))
+	}
+
+	req = talentRequest(http.MethodDelete, id, "")
+	req.Header.Set("If-Match", `"1"`)
+	rec = httptest.NewRecorder()
+	handler.DeleteTalent(rec, req)
+	if rec.Code != http.StatusPreconditionFailed {
+		t.Errorf("expected 412, got %d", rec.Code)
+	}
+
+	req = talentRequest(http.MethodDelete, id, "")
+	req.Header.Set("If-Match", `W/"2"`)
+	rec = httptest.NewRecorder()
+	handler.DeleteTalent(rec, req)
+	if rec.Code != http.StatusPreconditionFailed {
+		t.Errorf("expected 412 for a weak ETag, got %d", rec.Code)
+	}
+}
+
+func TestArchiveTalent(t *testing.T) {
+	handler, id := newTestHandlerWithTalent(t)
+
+	req := talentRequest(http.MethodPost, id, "")
+	req.Header.Set("If-Match", `"1"`)
+	rec := httptest.NewRecorder()
+	handler.ArchiveTalent(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rec.Code)
+	}
+
+	req = talentRequest(http.MethodPost, id, "")
+	req.Header.Set("If-Match", `"2"`)
+	rec = httptest.NewRecorder()
+	handler.ArchiveTalent(rec, req)
+	if rec.Code != http.StatusConflict {
+		t.Errorf("expected 409 for an archived talent, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents", nil))
+	if strings.Contains(rec.Body.String(), id) {
+		t.Error("expected archived talent to be hidden from the list")
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents?archived=true", nil))
+	if !strings.Contains(rec.Body.String(), id) {
+		t.Error("expected archived=true to include the archived talent")
+	}
+}
+
+func TestPatchTalentRejectsUnknownStage(t *testing.T) {
+	handler, id := newTestHandlerWithTalent(t)
+
+	req := talentRequest(http.MethodPatch, id, `{"stage":"hiring"}`)
+	req.Header.Set("If-Match", `"1"`)
+	rec := httptest.NewRecorder()
+	handler.PatchTalent(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestInvalidTalentIsBadRequest(t *testing.T) {
+	handler, id := newTestHandlerWithTalent(t)
+	invalid := strings.Replace(talentBody, "John Doe", "", 1)
+
+	rec := httptest.NewRecorder()
+	handler.CreateTalent(rec, httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(invalid)))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 on create, got %d", rec.Code)
+	}
+
+	req := talentRequest(http.MethodPut, id, invalid)
+	req.Header.Set("If-Match", `"1"`)
+	rec = httptest.NewRecorder()
+	handler.UpdateTalent(rec, req)
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "name is null") {
+		t.Errorf("expected 400 on update, got %d %s", rec.Code, rec.Body.String())
+	}
+
+	req = talentRequest(http.MethodPatch, id, `{"headline":""}`)
+	req.Header.Set("If-Match", `"1"`)
+	rec = httptest.NewRecorder()
+	handler.PatchTalent(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 on patch, got %d", rec.Code)
+	}
+}
+
+func TestListTalentsByNormalizedRole(t *testing.T) {
+	handler, _ := newTestHandlerWithTalent(t)
+
+	rec := httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents?function=backend", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"function":"backend"`) {
+		t.Errorf("expected the backend talent, got %d %s", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents?function=backend&seniority=senior", nil))
+	if strings.Contains(rec.Body.String(), `"function":"backend"`) {
+		t.Errorf("expected no senior talent, got %s", rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents?function=sales", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestTalentContacts(t *testing.T) {
+	handler := NewHandler(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+	body := strings.TrimSuffix(talentBody, "}") + `,"contacts":{"emails":["john@example.com"],"github":"johndoe"}}`
+
+	rec := httptest.NewRecorder()
+	handler.CreateTalent(rec, httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(body)))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.CreateTalent(rec, httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(body)))
+	if rec.Code != http.StatusConflict {
+		t.Errorf("expected 409 for a duplicate email, got %d", rec.Code)
+	}
+
+	invalid := strings.TrimSuffix(talentBody, "}") + `,"contacts":{"phones":["123"]}}`
+	rec = httptest.NewRecorder()
+	handler.CreateTalent(rec, httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(invalid)))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid phone, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents?contact=github.com/johndoe", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"emails":["john@example.com"]`) {
+		t.Errorf("expected the talent found by GitHub profile, got %d %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestTalentLocation(t *testing.T) {
+	handler := NewHandler(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+	body := strings.TrimSuffix(talentBody, "}") + `,"location":{"city":"Porto","country":"pt","work_model":"remote"}}`
+
+	rec := httptest.NewRecorder()
+	handler.CreateTalent(rec, httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(body)))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+
+	invalid := strings.TrimSuffix(talentBody, "}") + `,"location":{"timezone":"Mars/Olympus"}}`
+	rec = httptest.NewRecorder()
+	handler.CreateTalent(rec, httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(invalid)))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid timezone, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents?country=PT&work_model=remote", nil))
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"country":"PT"`) {
+		t.Errorf("expected the talent in Portugal, got %d %s", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents?work_model=nomad", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestCompensationVisibility(t *testing.T) {
+	cfg := testConfig()
+	cfg.Server.RecruiterToken = "recruiter"
+	server := NewServer(cfg, metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+	send := func(method string, path string, body string, token string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, adminRequest(method, path, body, token))
+		return rec
+	}
+	body := strings.TrimSuffix(talentBody, "}") + `,"compensation":{"amount":1500000,"currency":"BRL","period":"monthly","contract":"clt"}}`
+
+	if rec := send(http.MethodPost, "/talent", body, "token"); rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403 for the API token, got %d", rec.Code)
+	}
+	rec := send(http.MethodPost, "/talent", body, "recruiter")
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+	location := rec.Header().Get("Location")
+
+	if rec := send(http.MethodGet, location, "", "token"); strings.Contains(rec.Body.String(), "compensation") {
+		t.Errorf("expected the compensation to be hidden, got %s", rec.Body.String())
+	} else if !slices.Contains(rec.Header().Values("Vary"), "Authorization") {
+		t.Errorf("expected the response to vary by token, got %v", rec.Header().Values("Vary"))
+	}
+	if rec := send(http.MethodGet, location, "", "recruiter"); !strings.Contains(rec.Body.String(), `"amount":1500000`) {
+		t.Errorf("expected the compensation, got %s", rec.Body.String())
+	}
+
+	if rec := send(http.MethodGet, "/talents?compensation_currency=BRL&compensation_min=1000000", "", "token"); rec.Code != http.StatusForbidden {
+		t.Errorf("expected 403 for the API token, got %d", rec.Code)
+	}
+	if rec := send(http.MethodGet, "/talents?compensation_currency=BRL&compensation_min=1000000", "", "recruiter"); !strings.Contains(rec.Body.String(), `"amount":1500000`) {
+		t.Errorf("expected the talent, got %d %s", rec.Code, rec.Body.String())
+	}
+	if rec := send(http.MethodGet, "/talents?compensation_min=ten", "", "recruiter"); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400, got %d", rec.Code)
+	}
+}
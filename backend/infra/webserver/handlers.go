@@ -1,43 +1,119 @@
 package webserver
 
 import (
+	"context"
 	"crypto/subtle"
 	"encoding/json"
-	"log"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
 	"github.com/allanCordeiro/talent-db/application/usecase"
 	_ "github.com/allanCordeiro/talent-db/docs"
+	"github.com/allanCordeiro/talent-db/infra/config"
+	"github.com/allanCordeiro/talent-db/infra/metrics"
 )
 
+// Dependencies groups the gateways and collaborators the handlers need.
+type Dependencies struct {
+	TalentGateway      domain.TalentGateway
+	IdempotencyGateway domain.IdempotencyGateway
+	WebhookGateway     domain.WebhookGateway
+	WebhookQueue       domain.WebhookQueue
+	TaskGateway        domain.TaskGateway
+	StatsGateway       domain.StatsGateway
+	TagGateway         domain.TagGateway
+	TagSuggester       domain.TagSuggester
+	AttachmentGateway  domain.AttachmentGateway
+	BlobStore          domain.BlobStore
+	ExtractionQueue    domain.ExtractionQueue
+	SavedSearchGateway domain.SavedSearchGateway
+	ShortlistGateway   domain.ShortlistGateway
+	ScorecardGateway   domain.ScorecardGateway
+	Clock              domain.Clock
+}
+
 type Handler struct {
-	TalentGateway domain.TalentGateway
-	token         string
+	TalentGateway      domain.TalentGateway
+	IdempotencyGateway domain.IdempotencyGateway
+	WebhookGateway     domain.WebhookGateway
+	WebhookQueue       domain.WebhookQueue
+	TaskGateway        domain.TaskGateway
+	StatsGateway       domain.StatsGateway
+	TagGateway         domain.TagGateway
+	TagSuggester       domain.TagSuggester
+	AttachmentGateway  domain.AttachmentGateway
+	BlobStore          domain.BlobStore
+	ExtractionQueue    domain.ExtractionQueue
+	SavedSearchGateway domain.SavedSearchGateway
+	ShortlistGateway   domain.ShortlistGateway
+	ScorecardGateway   domain.ScorecardGateway
+	Clock              domain.Clock
+	token              string
+	adminToken         string
+	recruiterToken     string
+	idempotencyTTL     time.Duration
+	readinessTimeout   time.Duration
+	maxBodyBytes       int64
+	maxAttachmentBytes int64
+	rateLimiters       *rateLimiters
+	metrics            *metrics.Metrics
 }
 
-func NewHandler(talentGateway domain.TalentGateway, token string) *Handler {
+func NewHandler(cfg config.Config, m *metrics.Metrics, deps Dependencies) *Handler {
 	return &Handler{
-		TalentGateway: talentGateway,
-		token:         token,
+		TalentGateway:      deps.TalentGateway,
+		IdempotencyGateway: deps.IdempotencyGateway,
+		WebhookGateway:     deps.WebhookGateway,
+		WebhookQueue:       deps.WebhookQueue,
+		TaskGateway:        deps.TaskGateway,
+		StatsGateway:       deps.StatsGateway,
+		TagGateway:         deps.TagGateway,
+		TagSuggester:       deps.TagSuggester,
+		AttachmentGateway:  deps.AttachmentGateway,
+		BlobStore:          deps.BlobStore,
+		ExtractionQueue:    deps.ExtractionQueue,
+		SavedSearchGateway: deps.SavedSearchGateway,
+		ShortlistGateway:   deps.ShortlistGateway,
+		ScorecardGateway:   deps.ScorecardGateway,
+		Clock:              deps.Clock,
+		token:              cfg.Server.APIToken,
+		adminToken:         cfg.Server.AdminToken,
+		recruiterToken:     cfg.Server.RecruiterToken,
+		idempotencyTTL:     cfg.Idempotency.TTL,
+		readinessTimeout:   cfg.Server.ReadinessTimeout,
+		maxBodyBytes:       cfg.Server.MaxBodyBytes,
+		maxAttachmentBytes: cfg.Attachments.MaxBytes,
+		rateLimiters:       newRateLimiters(cfg.RateLimit),
+		metrics:            m,
 	}
 }
 
 type CreateTalentResponse struct {
-	Value string `json:"value"`
+	Value         string   `json:"value"`
+	SuggestedTags []string `json:"suggested_tags,omitempty"`
 }
 
 // CreateTalent godoc
 // @Summary Cria um talento
-// @Description Cadastra um talento com os dados enviados no corpo da requisição.
+// @Description Cadastra um talento com os dados enviados no corpo da requisição. Sem tags, aplica as sugeridas a partir do headline e dos cargos e as devolve em suggested_tags.
 // @Tags talents
 // @Accept json
 // @Produce json
 // @Param talent body usecase.CreateTalentInputDTO true "Dados do talento"
+// @Param Idempotency-Key header string false "Chave para repetir a requisição sem duplicar o talento"
 // @Success 201 {object} CreateTalentResponse "Recurso criado"
 // @Header 201 {string} Location "URL do talento recém-criado"
 // @Failure 400 {string} string "bad request"
+// @Failure 403 {string} string "compensation needs a recruiter or admin token"
+// @Failure 409 {string} string "email used by another talent or request with the same idempotency key in progress"
+// @Failure 413 {string} string "request body too large"
+// @Failure 422 {string} string "idempotency key reused with a different body"
+// @Failure 429 {string} string "too many requests"
 // @Failure 500 {string} string "internal error"
 // @Router /talent [post]
 func (h *Handler) CreateTalent(w http.ResponseWriter, r *http.Request) {
@@ -46,60 +122,77 @@ func (h *Handler) CreateTalent(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	err := json.NewDecoder(r.Body).Decode(&input)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		log.Println("data decoder error: " + err.Error())
+		writeDecodeError(w, r, err)
 		return
 	}
 
-	uc := usecase.NewCreateTalentUseCase(r.Context(), h.TalentGateway)
+	uc := usecase.NewCreateTalentUseCase(r.Context(), h.TalentGateway, h.TagGateway, h.TagSuggester)
 	output, err := uc.Execute(usecase.CreateTalentInputDTO{
-		ProfileURL:     input.ProfileURL,
-		PossibleRole:   input.PossibleRole,
-		FullName:       input.FullName,
-		Headline:       input.Headline,
-		CurrentCompany: input.CurrentCompany,
-		CurrentRole:    input.CurrentRole,
-		Tags:           input.Tags,
-		Notes:          input.Notes,
+		ProfileURL:         input.ProfileURL,
+		PossibleRole:       input.PossibleRole,
+		FullName:           input.FullName,
+		Headline:           input.Headline,
+		CurrentCompany:     input.CurrentCompany,
+		CurrentRole:        input.CurrentRole,
+		Tags:               input.Tags,
+		Notes:              input.Notes,
+		Contacts:           input.Contacts,
+		Location:           input.Location,
+		Compensation:       input.Compensation,
+		CompensationAccess: hasCompensationAccess(r),
 	})
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		log.Println("application error: " + err.Error())
+		writeTalentError(w, r, err)
 		return
 	}
 
 	response := CreateTalentResponse{
-		Value: "/talent/" + output.Id,
+		Value:         "/talent/" + output.Id,
+		SuggestedTags: output.SuggestedTags,
 	}
 	w.Header().Add("Location", response.Value)
 	w.WriteHeader(http.StatusCreated)
-
+	_ = json.NewEncoder(w).Encode(response)
 }
 
 // GetTalent godoc
 // @Summary Busca um talento
-// @Description Retorna os dados completos de um talento específico.
+// @Description Retorna os dados completos de um talento específico. A versão atual é enviada no header ETag. A pretensão salarial só aparece para tokens de recrutador ou admin.
 // @Tags talents
 // @Produce json
 // @Param id path string true "ID do talento"
+// @Param If-None-Match header string false "ETag já conhecido pelo cliente"
 // @Success 200 {object} usecase.GetTalentOutputDTO
+// @Header 200 {string} ETag "Versão atual do talento"
+// @Success 304 {string} string "not modified"
 // @Failure 404 {string} string "talent not found"
+// @Failure 429 {string} string "too many requests"
 // @Failure 500 {string} string "internal error"
 // @Router /talent/{id} [get]
 func (h *Handler) GetTalent(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
+	// the compensation depends on the token, so the same version has one
+	// representation per principal
+	w.Header().Add("Vary", "Authorization")
 	input := usecase.GetTalentInputDTO{
-		Id: r.PathValue("id"),
+		Id:                 r.PathValue("id"),
+		CompensationAccess: hasCompensationAccess(r),
 	}
 	uc := usecase.NewGetTalentUseCase(r.Context(), h.TalentGateway)
 	output, err := uc.Execute(input)
 	if err != nil {
-		if err.Error() == "talent not found" {
+		if errors.Is(err, domain.ErrTalentNotFound) {
 			w.WriteHeader(http.StatusNotFound)
 			return
 		}
 		w.WriteHeader(http.StatusInternalServerError)
-		log.Println("application error: " + err.Error())
+		logging.FromContext(r.Context()).Error("application error", "error", err)
+		return
+	}
+
+	w.Header().Set("ETag", formatETag(output.Version))
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" && matchesETag(ifNoneMatch, output.Version) {
+		w.WriteHeader(http.StatusNotModified)
 		return
 	}
 
@@ -108,6 +201,169 @@ func (h *Handler) GetTalent(w http.ResponseWriter, r *http.Request) {
 
 }
 
+// UpdateTalent godoc
+// @Summary Atualiza um talento
+// @Description Substitui os dados de um talento. Exige o header If-Match com o ETag obtido na leitura.
+// @Tags talents
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do talento"
+// @Param If-Match header string true "ETag da versão que está sendo alterada"
+// @Param talent body usecase.UpdateTalentInputDTO true "Dados do talento"
+// @Success 204 {string} string "updated"
+// @Header 204 {string} ETag "Nova versão do talento"
+// @Failure 400 {string} string "bad request"
+// @Failure 403 {string} string "compensation needs a recruiter or admin token"
+// @Failure 413 {string} string "request body too large"
+// @Failure 404 {string} string "talent not found"
+// @Failure 409 {string} string "email used by another talent"
+// @Failure 412 {string} string "version mismatch"
+// @Failure 428 {string} string "If-Match header required"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /talent/{id} [put]
+func (h *Handler) UpdateTalent(w http.ResponseWriter, r *http.Request) {
+	version, ok := requireIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var input usecase.UpdateTalentInputDTO
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	input.Id = r.PathValue("id")
+	input.Version = version
+	input.CompensationAccess = hasCompensationAccess(r)
+
+	uc := usecase.NewUpdateTalentUseCase(r.Context(), h.TalentGateway, h.TagGateway)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeTalentError(w, r, err)
+		return
+	}
+
+	w.Header().Set("ETag", formatETag(output.Version))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// PatchTalent godoc
+// @Summary Altera parcialmente um talento
+// @Description Altera apenas os campos enviados. Exige o header If-Match com o ETag obtido na leitura.
+// @Tags talents
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do talento"
+// @Param If-Match header string true "ETag da versão que está sendo alterada"
+// @Param talent body usecase.PatchTalentInputDTO true "Campos a alterar"
+// @Success 204 {string} string "updated"
+// @Header 204 {string} ETag "Nova versão do talento"
+// @Failure 400 {string} string "bad request"
+// @Failure 403 {string} string "compensation needs a recruiter or admin token"
+// @Failure 413 {string} string "request body too large"
+// @Failure 404 {string} string "talent not found"
+// @Failure 409 {string} string "email used by another talent or talent archived"
+// @Failure 412 {string} string "version mismatch"
+// @Failure 428 {string} string "If-Match header required"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /talent/{id} [patch]
+func (h *Handler) PatchTalent(w http.ResponseWriter, r *http.Request) {
+	version, ok := requireIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var input usecase.PatchTalentInputDTO
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	input.Id = r.PathValue("id")
+	input.Version = version
+	input.CompensationAccess = hasCompensationAccess(r)
+
+	uc := usecase.NewPatchTalentUseCase(r.Context(), h.TalentGateway, h.TagGateway)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeTalentError(w, r, err)
+		return
+	}
+
+	w.Header().Set("ETag", formatETag(output.Version))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ArchiveTalent godoc
+// @Summary Arquiva um talento
+// @Description Arquiva um talento sem removê-lo. Talentos arquivados somem da listagem padrão. Exige o header If-Match com o ETag obtido na leitura.
+// @Tags talents
+// @Param id path string true "ID do talento"
+// @Param If-Match header string true "ETag da versão que está sendo arquivada"
+// @Success 204 {string} string "archived"
+// @Header 204 {string} ETag "Nova versão do talento"
+// @Failure 404 {string} string "talent not found"
+// @Failure 409 {string} string "talent already archived"
+// @Failure 412 {string} string "version mismatch"
+// @Failure 428 {string} string "If-Match header required"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /talent/{id}/archive [post]
+func (h *Handler) ArchiveTalent(w http.ResponseWriter, r *http.Request) {
+	version, ok := requireIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	uc := usecase.NewArchiveTalentUseCase(r.Context(), h.TalentGateway)
+	output, err := uc.Execute(usecase.ArchiveTalentInputDTO{
+		Id:      r.PathValue("id"),
+		Version: version,
+	})
+	if err != nil {
+		writeTalentError(w, r, err)
+		return
+	}
+
+	w.Header().Set("ETag", formatETag(output.Version))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteTalent godoc
+// @Summary Remove um talento
+// @Description Remove um talento. Exige o header If-Match com o ETag obtido na leitura.
+// @Tags talents
+// @Param id path string true "ID do talento"
+// @Param If-Match header string true "ETag da versão que está sendo removida"
+// @Success 204 {string} string "deleted"
+// @Failure 404 {string} string "talent not found"
+// @Failure 412 {string} string "version mismatch"
+// @Failure 428 {string} string "If-Match header required"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /talent/{id} [delete]
+func (h *Handler) DeleteTalent(w http.ResponseWriter, r *http.Request) {
+	version, ok := requireIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	uc := usecase.NewDeleteTalentUseCase(r.Context(), h.TalentGateway, h.TagGateway)
+	err := uc.Execute(usecase.DeleteTalentInputDTO{
+		Id:      r.PathValue("id"),
+		Version: version,
+	})
+	if err != nil {
+		writeTalentError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // ListTalents godoc
 // @Summary Lista talentos
 // @Description Retorna uma lista de talentos com paginação e filtros em memória.
@@ -116,31 +372,101 @@ func (h *Handler) GetTalent(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param limit query int false "Limite de registros por página"
 // @Param cursor query string false "Cursor para próxima página"
+// @Param q query string false "Busca por palavras (AND, prefixo) no nome, headline, cargos, empresa, notas, tags e no texto dos currículos anexados"
 // @Param name query string false "Filtro por nome (substring, case-insensitive)"
 // @Param possible_role query string false "Filtro por possible role (substring, case-insensitive)"
 // @Param tags query []string false "Tags (AND) - múltiplos valores ex: ?tags=go&tags=backend"
+// @Param stage query string false "Etapa do funil (sourced, contacted, screening, interviewing, offer, hired, rejected)"
+// @Param archived query bool false "Inclui talentos arquivados"
+// @Param function query string false "Área normalizada do cargo (backend, frontend, fullstack, mobile, data, devops, qa, security, design, product, software)"
+// @Param seniority query string false "Senioridade normalizada (junior, mid, senior, staff, lead)"
+// @Param contact query string false "Busca por email, telefone, usuário do GitHub ou URL de perfil (ignora o cursor)"
+// @Param country query []string false "Países (ISO 3166-1 alpha-2, OR) - múltiplos valores ex: ?country=BR&country=PT"
+// @Param state query string false "Estado"
+// @Param city query string false "Cidade (ignora acentos e maiúsculas)"
+// @Param timezone query string false "Fuso horário IANA, ex: America/Sao_Paulo"
+// @Param work_model query string false "Modelo de trabalho (remote, hybrid, onsite)"
+// @Param relocation query bool false "Apenas talentos dispostos a se mudar"
+// @Param compensation_currency query string false "Moeda da pretensão salarial (ISO 4217), obrigatória com min ou max. Exige token de recrutador ou admin"
+// @Param compensation_period query string false "Período de min e max (monthly, yearly), padrão monthly"
+// @Param compensation_min query int false "Pretensão mínima em centavos"
+// @Param compensation_max query int false "Pretensão máxima em centavos"
+// @Param contract query string false "Tipo de contrato (clt, pj, contractor)"
+// @Param sort query string false "Ordenação por até 2 chaves separadas por vírgula (captured_at, updated_at, full_name, current_company, rating), com - para decrescente, ex: full_name,-captured_at. Padrão -captured_at. Textos são comparados byte a byte (maiúsculas primeiro). Talentos sem o campo ordenado ficam de fora: os cadastrados antes de updated_at ou de rating existirem só aparecem após o backfill (go run ./cmd/backfill). O cursor só vale para a mesma ordenação"
+// @Success 200 {object} usecase.ListTalentsOutputDTO
+// @Failure 400 {string} string "bad request"
 // @Failure 401 {string} string "unauthorized"
+// @Failure 429 {string} string "too many requests"
 // @Failure 500 {string} string "internal error"
+// @Failure 403 {string} string "compensation filters need a recruiter or admin token"
 // @Router /talents [get]
 func (h *Handler) ListTalents(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Add("Vary", "Authorization")
 	limitParam := r.URL.Query().Get("limit")
 	cursorParam := r.URL.Query().Get("cursor")
 	nameParam := r.URL.Query().Get("name")
 	possibleRoleParam := r.URL.Query().Get("possible_role")
 	tagsParam := r.URL.Query()["tags"]
+	stageParam := r.URL.Query().Get("stage")
+	archivedParam, _ := strconv.ParseBool(r.URL.Query().Get("archived"))
+	relocationParam, _ := strconv.ParseBool(r.URL.Query().Get("relocation"))
+	compensationMin, errMin := parseAmount(r.URL.Query().Get("compensation_min"))
+	compensationMax, errMax := parseAmount(r.URL.Query().Get("compensation_max"))
+	if err := errors.Join(errMin, errMax); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
 
 	uc := usecase.NewListTalentUseCase(r.Context(), h.TalentGateway)
 	output, err := uc.Execute(usecase.ListTalentsInputDTO{
-		Limit:        parseToInt(limitParam, 50),
-		Cursor:       cursorParam,
-		Name:         nameParam,
-		PossibleRole: possibleRoleParam,
-		Tags:         tagsParam,
+		Limit:   parseToInt(limitParam, 50),
+		Cursor:  cursorParam,
+		Contact: r.URL.Query().Get("contact"),
+		Sort:    r.URL.Query().Get("sort"),
+		Filter: domain.TalentFilter{
+			Query:        r.URL.Query().Get("q"),
+			Name:         nameParam,
+			PossibleRole: possibleRoleParam,
+			Tags:         tagsParam,
+			Stage:        stageParam,
+			Function:     r.URL.Query().Get("function"),
+			Seniority:    r.URL.Query().Get("seniority"),
+			Location: domain.LocationFilter{
+				Countries:        r.URL.Query()["country"],
+				State:            r.URL.Query().Get("state"),
+				City:             r.URL.Query().Get("city"),
+				Timezone:         r.URL.Query().Get("timezone"),
+				WorkModel:        r.URL.Query().Get("work_model"),
+				OpenToRelocation: relocationParam,
+			},
+			Compensation: domain.CompensationFilter{
+				Currency: r.URL.Query().Get("compensation_currency"),
+				Period:   r.URL.Query().Get("compensation_period"),
+				Min:      compensationMin,
+				Max:      compensationMax,
+				Contract: r.URL.Query().Get("contract"),
+			},
+			IncludeArchived: archivedParam,
+		},
+		CompensationAccess: hasCompensationAccess(r),
 	})
+	if errors.Is(err, domain.ErrInvalidRoleProfile) || errors.Is(err, domain.ErrInvalidContact) || errors.Is(err, domain.ErrInvalidLocation) ||
+		errors.Is(err, domain.ErrInvalidCompensation) || errors.Is(err, domain.ErrInvalidStage) || errors.Is(err, domain.ErrInvalidSort) ||
+		errors.Is(err, domain.ErrInvalidCursor) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	if errors.Is(err, domain.ErrCompensationForbidden) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		log.Println("application error: " + err.Error())
+		logging.FromContext(r.Context()).Error("application error", "error", err)
 		return
 	}
 
@@ -148,17 +474,97 @@ func (h *Handler) ListTalents(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(output)
 }
 
+// protect wraps the API routes with throttling, authentication and the
+// request body limit.
+func (h *Handler) protect(next http.HandlerFunc) http.HandlerFunc {
+	return h.withRateLimit(h.withAuth(h.withBodyLimit(next)))
+}
+
 func (h *Handler) withAuth(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		authToken := h.token
 		if authToken == "" {
-			log.Panicf("API_TOKEN environment variable not set")
+			w.WriteHeader(http.StatusInternalServerError)
+			logging.FromContext(r.Context()).Error("auth error: API token not configured")
+			return
+		}
+		var principal string
+		switch {
+		case h.adminToken != "" && matchesToken(r, h.adminToken):
+			principal = principalAdmin
+		case h.recruiterToken != "" && matchesToken(r, h.recruiterToken):
+			principal = principalRecruiter
+		case matchesToken(r, authToken):
+			principal = principalAPIToken
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		setPrincipal(r, principal)
+		next(w, withPrincipal(r, principal))
+	}
+}
+
+// protectUpload is protect with room for an attachment and the multipart
+// envelope around it instead of the JSON body limit.
+func (h *Handler) protectUpload(next http.HandlerFunc) http.HandlerFunc {
+	return h.withRateLimit(h.withAuth(withBodyLimitOf(h.maxAttachmentBytes+multipartOverhead, next)))
+}
+
+// protectAdmin is protect for the /admin routes, which accept only the
+// admin token.
+func (h *Handler) protectAdmin(next http.HandlerFunc) http.HandlerFunc {
+	return h.withRateLimit(h.withAdminAuth(h.withBodyLimit(next)))
+}
+
+func (h *Handler) withAdminAuth(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authToken := h.adminToken
+		if authToken == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
 		}
 		if !checkAuth(w, r, authToken) {
 			return
 		}
-		next(w, r)
+		setPrincipal(r, principalAdmin)
+		next(w, withPrincipal(r, principalAdmin))
+	}
+}
+
+func writeTalentError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrTalentNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Is(err, domain.ErrVersionConflict):
+		w.WriteHeader(http.StatusPreconditionFailed)
+	case errors.Is(err, domain.ErrTalentArchived):
+		w.WriteHeader(http.StatusConflict)
+	case errors.Is(err, domain.ErrCompensationForbidden):
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrContactConflict):
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrInvalidTalent), errors.Is(err, domain.ErrInvalidStage), errors.Is(err, domain.ErrInvalidContact),
+		errors.Is(err, domain.ErrInvalidLocation), errors.Is(err, domain.ErrInvalidCompensation):
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logging.FromContext(r.Context()).Error("application error", "error", err)
+	}
+}
+
+func parseAmount(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	amount, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not an amount in minor units", value)
 	}
+	return amount, nil
 }
 
 func parseToInt(value string, defaultValue int) int {
@@ -173,13 +579,34 @@ func parseToInt(value string, defaultValue int) int {
 }
 
 func checkAuth(w http.ResponseWriter, r *http.Request, authToken string) bool {
-
-	providedToken := r.Header.Get("Authorization")
-	expected := "Bearer " + authToken
-	if subtle.ConstantTimeCompare([]byte(providedToken), []byte(expected)) != 1 {
+	if !matchesToken(r, authToken) {
 		w.WriteHeader(http.StatusUnauthorized)
 		return false
 	}
 	return true
+}
+
+func matchesToken(r *http.Request, authToken string) bool {
+	providedToken := r.Header.Get("Authorization")
+	expected := "Bearer " + authToken
+	return subtle.ConstantTimeCompare([]byte(providedToken), []byte(expected)) == 1
+}
+
+const (
+	principalAPIToken  = "api_token"
+	principalRecruiter = "recruiter"
+	principalAdmin     = "admin"
+)
+
+type principalKey struct{}
+
+func withPrincipal(r *http.Request, principal string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), principalKey{}, principal))
+}
 
+// hasCompensationAccess reports whether the caller authenticated as a
+// recruiter or an admin.
+func hasCompensationAccess(r *http.Request) bool {
+	principal, _ := r.Context().Value(principalKey{}).(string)
+	return principal == principalRecruiter || principal == principalAdmin
 }
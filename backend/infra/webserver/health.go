@@ -0,0 +1,65 @@
+package webserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/infra/buildinfo"
+)
+
+type HealthResponse struct {
+	Status string `json:"status"`
+}
+
+// Healthz godoc
+// @Summary Liveness
+// @Description Indica que o processo está no ar. Não depende do Firestore e não exige autenticação.
+// @Tags health
+// @Produce json
+// @Success 200 {object} HealthResponse
+// @Router /healthz [get]
+func (h *Handler) Healthz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(HealthResponse{Status: "ok"})
+}
+
+// Readyz godoc
+// @Summary Readiness
+// @Description Verifica se o banco de dados está acessível. Não exige autenticação.
+// @Tags health
+// @Produce json
+// @Success 200 {object} HealthResponse
+// @Failure 503 {object} HealthResponse
+// @Router /readyz [get]
+func (h *Handler) Readyz(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx, cancel := context.WithTimeout(r.Context(), h.readinessTimeout)
+	defer cancel()
+
+	err := h.TalentGateway.Ping(ctx)
+	if err != nil {
+		logging.FromContext(r.Context()).Warn("readiness error", "error", err)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_ = json.NewEncoder(w).Encode(HealthResponse{Status: "unavailable"})
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(HealthResponse{Status: "ok"})
+}
+
+// Version godoc
+// @Summary Versão
+// @Description Retorna o commit, a data do build e a versão do Go. Não exige autenticação.
+// @Tags health
+// @Produce json
+// @Success 200 {object} buildinfo.Info
+// @Router /version [get]
+func (h *Handler) Version(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(buildinfo.Get())
+}
@@ -0,0 +1,40 @@
+package webserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+func TestHealthEndpointsSkipAuth(t *testing.T) {
+	server := NewServer(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+
+	for _, path := range []string{"/healthz", "/readyz", "/version"} {
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != http.StatusOK {
+			t.Errorf("expected 200 on %s, got %d", path, rec.Code)
+		}
+	}
+}
+
+func TestReadyzWhenGatewayIsDown(t *testing.T) {
+	talents := NewInMemoryTalentGateway()
+	talents.pingErr = errors.New("connection refused")
+	server := NewServer(testConfig(), metrics.New(), testDependencies(talents, NewInMemoryIdempotencyGateway()))
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected liveness to stay 200, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,131 @@
+package webserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+)
+
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyPendingTTL bounds how long a request stopped halfway keeps its
+// key reserved.
+const idempotencyPendingTTL = time.Minute
+
+var replayedHeaders = []string{"Location", "Content-Type"}
+
+func (h *Handler) withIdempotency(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		if key == "" {
+			next(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeDecodeError(w, r, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		requestHash := hashRequest(r, body)
+
+		pending, err := domain.NewPendingIdempotencyRecord(key, requestHash, min(idempotencyPendingTTL, h.idempotencyTTL))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		record, err := h.IdempotencyGateway.ReserveIdempotencyKey(r.Context(), *pending)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			logging.FromContext(r.Context()).Error("idempotency error", "error", err)
+			return
+		}
+		if record != nil {
+			if !record.Matches(requestHash) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				return
+			}
+			if record.Pending {
+				http.Error(w, "a request with this idempotency key is in progress", http.StatusConflict)
+				return
+			}
+			h.metrics.DuplicatesRejected.WithLabelValues("idempotency_replay").Inc()
+			replayResponse(w, record)
+			return
+		}
+
+		recorder := newResponseRecorder(w)
+		next(recorder, r)
+
+		// failed requests release the key so the client is free to retry them
+		if recorder.status < 200 || recorder.status >= 300 {
+			err = h.IdempotencyGateway.DeleteIdempotencyRecord(context.WithoutCancel(r.Context()), key)
+			if err != nil {
+				logging.FromContext(r.Context()).Error("idempotency error", "error", err)
+			}
+			return
+		}
+		headers := make(map[string]string)
+		for _, name := range replayedHeaders {
+			if value := recorder.Header().Get(name); value != "" {
+				headers[name] = value
+			}
+		}
+		newRecord, err := domain.NewIdempotencyRecord(key, requestHash, recorder.status, headers, recorder.body.Bytes(), h.idempotencyTTL)
+		if err != nil {
+			logging.FromContext(r.Context()).Error("idempotency error", "error", err)
+			return
+		}
+		err = h.IdempotencyGateway.SaveIdempotencyRecord(context.WithoutCancel(r.Context()), *newRecord)
+		if err != nil {
+			logging.FromContext(r.Context()).Error("idempotency error", "error", err)
+		}
+	}
+}
+
+func hashRequest(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func replayResponse(w http.ResponseWriter, record *domain.IdempotencyRecord) {
+	for name, value := range record.Headers {
+		w.Header().Set(name, value)
+	}
+	w.Header().Set("Idempotent-Replayed", "true")
+	w.WriteHeader(record.StatusCode)
+	_, _ = w.Write(record.Body)
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
+	return &responseRecorder{
+		ResponseWriter: w,
+		status:         http.StatusOK,
+	}
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,160 @@
+package webserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+const talentBody = `{"profile_url":"https://linkedin.com/in/test","possible_role":"Backend Engineer","full_name":"John Doe","headline":"Senior Developer"}`
+
+func newIdempotentCreateRequest(key string, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(body))
+	req.Header.Set(idempotencyKeyHeader, key)
+	return req
+}
+
+func TestIdempotencyReplaysOriginalResponse(t *testing.T) {
+	talents := NewInMemoryTalentGateway()
+	handler := NewHandler(testConfig(), metrics.New(), testDependencies(talents, NewInMemoryIdempotencyGateway()))
+	create := handler.withIdempotency(handler.CreateTalent)
+
+	first := httptest.NewRecorder()
+	create(first, newIdempotentCreateRequest("key-1", talentBody))
+	second := httptest.NewRecorder()
+	create(second, newIdempotentCreateRequest("key-1", talentBody))
+
+	if first.Code != http.StatusCreated || second.Code != http.StatusCreated {
+		t.Fatalf("expected 201 on both requests, got %d and %d", first.Code, second.Code)
+	}
+
+	if first.Header().Get("Location") != second.Header().Get("Location") {
+		t.Errorf("expected same Location, got %s and %s", first.Header().Get("Location"), second.Header().Get("Location"))
+	}
+
+	if second.Header().Get("Idempotent-Replayed") != "true" {
+		t.Error("expected replayed response to be flagged")
+	}
+
+	if len(talents.talents) != 1 {
+		t.Errorf("expected 1 talent saved, got %d", len(talents.talents))
+	}
+}
+
+func TestIdempotencyKeyReusedWithDifferentBody(t *testing.T) {
+	handler := NewHandler(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+	create := handler.withIdempotency(handler.CreateTalent)
+
+	create(httptest.NewRecorder(), newIdempotentCreateRequest("key-1", talentBody))
+	rec := httptest.NewRecorder()
+	create(rec, newIdempotentCreateRequest("key-1", strings.Replace(talentBody, "John Doe", "Jane Doe", 1)))
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("expected 422, got %d", rec.Code)
+	}
+}
+
+func TestIdempotencyExpiredKeyCreatesAgain(t *testing.T) {
+	talents := NewInMemoryTalentGateway()
+	idempotency := NewInMemoryIdempotencyGateway()
+	handler := NewHandler(testConfig(), metrics.New(), testDependencies(talents, idempotency))
+	create := handler.withIdempotency(handler.CreateTalent)
+
+	create(httptest.NewRecorder(), newIdempotentCreateRequest("key-1", talentBody))
+	record := idempotency.records["key-1"]
+	record.ExpiresAt = time.Now().UTC().Add(-time.Minute)
+	idempotency.records["key-1"] = record
+
+	create(httptest.NewRecorder(), newIdempotentCreateRequest("key-1", talentBody))
+
+	if len(talents.talents) != 2 {
+		t.Errorf("expected 2 talents saved, got %d", len(talents.talents))
+	}
+}
+
+func TestWithoutIdempotencyKeyEveryRequestCreates(t *testing.T) {
+	talents := NewInMemoryTalentGateway()
+	handler := NewHandler(testConfig(), metrics.New(), testDependencies(talents, NewInMemoryIdempotencyGateway()))
+	create := handler.withIdempotency(handler.CreateTalent)
+
+	create(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(talentBody)))
+	create(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(talentBody)))
+
+	if len(talents.talents) != 2 {
+		t.Errorf("expected 2 talents saved, got %d", len(talents.talents))
+	}
+}
+
+func TestIdempotencyKeyInProgress(t *testing.T) {
+	talents := NewInMemoryTalentGateway()
+	idempotency := NewInMemoryIdempotencyGateway()
+	handler := NewHandler(testConfig(), metrics.New(), testDependencies(talents, idempotency))
+	create := handler.withIdempotency(handler.CreateTalent)
+
+	req := newIdempotentCreateRequest("key-1", talentBody)
+	pending, _ := domain.NewPendingIdempotencyRecord("key-1", hashRequest(req, []byte(talentBody)), time.Minute)
+	idempotency.records["key-1"] = *pending
+
+	rec := httptest.NewRecorder()
+	create(rec, req)
+
+	if rec.Code != http.StatusConflict {
+		t.Errorf("expected 409, got %d", rec.Code)
+	}
+	if len(talents.talents) != 0 {
+		t.Errorf("expected no talent saved, got %d", len(talents.talents))
+	}
+}
+
+func TestIdempotencyConcurrentRequestsCreateOnce(t *testing.T) {
+	talents := NewInMemoryTalentGateway()
+	handler := NewHandler(testConfig(), metrics.New(), testDependencies(talents, NewInMemoryIdempotencyGateway()))
+	create := handler.protect(handler.withIdempotency(handler.CreateTalent))
+
+	var wg sync.WaitGroup
+	codes := make(chan int, 10)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := newIdempotentCreateRequest("key-1", talentBody)
+			req.Header.Set("Authorization", "Bearer token")
+			rec := httptest.NewRecorder()
+			create(rec, req)
+			codes <- rec.Code
+		}()
+	}
+	wg.Wait()
+	close(codes)
+
+	for code := range codes {
+		if code != http.StatusCreated && code != http.StatusConflict {
+			t.Errorf("expected 201 or 409, got %d", code)
+		}
+	}
+	if len(talents.talents) != 1 {
+		t.Errorf("expected 1 talent saved, got %d", len(talents.talents))
+	}
+}
+
+func TestIdempotencyFailedRequestReleasesKey(t *testing.T) {
+	idempotency := NewInMemoryIdempotencyGateway()
+	handler := NewHandler(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), idempotency))
+	create := handler.withIdempotency(handler.CreateTalent)
+
+	rec := httptest.NewRecorder()
+	create(rec, newIdempotentCreateRequest("key-1", `{`))
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+	if _, exists := idempotency.records["key-1"]; exists {
+		t.Error("expected the key to be released after a failed request")
+	}
+}
@@ -0,0 +1,88 @@
+package webserver
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._\-]{1,128}$`)
+
+// requestInfo is filled by the inner middlewares (withAuth) so the access log
+// written by withRequestLogging can report it.
+type requestInfo struct {
+	principal string
+}
+
+type requestInfoKey struct{}
+
+func withRequestLogging(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		requestID := r.Header.Get(requestIDHeader)
+		if !validRequestID.MatchString(requestID) {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(requestIDHeader, requestID)
+
+		info := &requestInfo{}
+		logger := slog.Default().With(slog.String("request_id", requestID))
+		if spanContext := trace.SpanContextFromContext(r.Context()); spanContext.HasTraceID() {
+			logger = logger.With(slog.String("trace_id", spanContext.TraceID().String()))
+		}
+		ctx := logging.WithLogger(r.Context(), logger)
+		ctx = context.WithValue(ctx, requestInfoKey{}, info)
+		r = r.WithContext(ctx)
+
+		sw := newStatusWriter(w)
+		next.ServeHTTP(sw, r)
+
+		level := slog.LevelInfo
+		if sw.status >= http.StatusInternalServerError {
+			level = slog.LevelError
+		}
+		logger.LogAttrs(ctx, level, "request completed",
+			slog.String("method", r.Method),
+			slog.String("route", r.Pattern),
+			slog.String("path", r.URL.Path),
+			slog.Int("status", sw.status),
+			slog.Int64("latency_ms", time.Since(start).Milliseconds()),
+			slog.String("principal", info.principal),
+		)
+	})
+}
+
+func setPrincipal(r *http.Request, principal string) {
+	if info, ok := r.Context().Value(requestInfoKey{}).(*requestInfo); ok {
+		info.principal = principal
+	}
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func newStatusWriter(w http.ResponseWriter) *statusWriter {
+	return &statusWriter{
+		ResponseWriter: w,
+		status:         http.StatusOK,
+	}
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
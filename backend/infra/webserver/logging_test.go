@@ -0,0 +1,68 @@
+package webserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+func captureLogs(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+func TestRequestLoggingPropagatesRequestID(t *testing.T) {
+	logs := captureLogs(t)
+	server := NewServer(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+
+	req := httptest.NewRequest(http.MethodGet, "/talents", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set(requestIDHeader, "plugin-123")
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+
+	if rec.Header().Get(requestIDHeader) != "plugin-123" {
+		t.Errorf("expected request id plugin-123, got %s", rec.Header().Get(requestIDHeader))
+	}
+
+	var entry map[string]any
+	err := json.Unmarshal(logs.Bytes(), &entry)
+	if err != nil {
+		t.Fatalf("expected one JSON log line, got %s", logs.String())
+	}
+
+	expected := map[string]any{
+		"request_id": "plugin-123",
+		"route":      "GET /talents",
+		"status":     float64(http.StatusOK),
+		"principal":  "api_token",
+	}
+	for key, value := range expected {
+		if entry[key] != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, entry[key])
+		}
+	}
+}
+
+func TestRequestLoggingGeneratesRequestID(t *testing.T) {
+	captureLogs(t)
+	server := NewServer(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	req.Header.Set(requestIDHeader, "not a valid id\n")
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+
+	requestID := rec.Header().Get(requestIDHeader)
+	if requestID == "" || requestID == "not a valid id\n" {
+		t.Errorf("expected a generated request id, got %q", requestID)
+	}
+}
@@ -0,0 +1,24 @@
+package webserver
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+func withMetrics(m *metrics.Metrics, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := newStatusWriter(w)
+		next.ServeHTTP(sw, r)
+
+		route := r.Pattern
+		if route == "" {
+			route = "unmatched"
+		}
+		m.HTTPRequests.WithLabelValues(route, r.Method, strconv.Itoa(sw.status)).Inc()
+		m.HTTPDuration.WithLabelValues(route, r.Method).Observe(time.Since(start).Seconds())
+	})
+}
@@ -0,0 +1,37 @@
+package webserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+func TestMetricsEndpointExposesRequestCounters(t *testing.T) {
+	server := NewServer(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(talentBody))
+		req.Header.Set("Authorization", "Bearer token")
+		req.Header.Set(idempotencyKeyHeader, "key-1")
+		server.Handler().ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	body := rec.Body.String()
+	for _, expected := range []string{
+		`talentdb_http_requests_total{method="POST",route="POST /talent",status="201"} 2`,
+		`talentdb_duplicates_rejected_total{reason="idempotency_replay"} 1`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("expected metrics to contain %s", expected)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package webserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/infra/config"
+	"golang.org/x/time/rate"
+)
+
+// buckets idle for this long are dropped, which for the configured rates
+// means they were full again anyway
+const bucketIdleTimeout = 10 * time.Minute
+
+type rateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+func newRateLimiter(budget config.RateBudget) *rateLimiter {
+	return &rateLimiter{
+		limit:   rate.Limit(float64(budget.RequestsPerMinute) / 60),
+		burst:   budget.Burst,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// reserve takes a token for key, returning how long the caller has to wait
+// when the bucket is empty.
+func (l *rateLimiter) reserve(key string, now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) > time.Minute {
+		for k, b := range l.buckets {
+			if now.Sub(b.lastSeen) > bucketIdleTimeout {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, exists := l.buckets[key]
+	if !exists {
+		b = &bucket{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	reservation := b.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return time.Minute, false
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+type rateLimiters struct {
+	enabled    bool
+	trustProxy bool
+	keyRead    *rateLimiter
+	keyWrite   *rateLimiter
+	ipRead     *rateLimiter
+	ipWrite    *rateLimiter
+}
+
+func newRateLimiters(cfg config.RateLimitConfig) *rateLimiters {
+	return &rateLimiters{
+		enabled:    cfg.Enabled,
+		trustProxy: cfg.TrustProxy,
+		keyRead:    newRateLimiter(cfg.KeyRead),
+		keyWrite:   newRateLimiter(cfg.KeyWrite),
+		ipRead:     newRateLimiter(cfg.IPRead),
+		ipWrite:    newRateLimiter(cfg.IPWrite),
+	}
+}
+
+// withRateLimit runs before withAuth so that clients hammering the API with
+// bad tokens are throttled by IP as well.
+func (h *Handler) withRateLimit(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limits := h.rateLimiters
+		if !limits.enabled {
+			next(w, r)
+			return
+		}
+
+		keyLimiter, ipLimiter := limits.keyRead, limits.ipRead
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			keyLimiter, ipLimiter = limits.keyWrite, limits.ipWrite
+		}
+
+		now := time.Now()
+		wait, ok := ipLimiter.reserve(clientIP(r, limits.trustProxy), now)
+		if ok {
+			if authorization := r.Header.Get("Authorization"); authorization != "" {
+				wait, ok = keyLimiter.reserve(hashKey(authorization), now)
+			}
+		}
+		if !ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			w.WriteHeader(http.StatusTooManyRequests)
+			logging.FromContext(r.Context()).Warn("rate limit exceeded", "retry_after", wait.String())
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (h *Handler) withBodyLimit(next http.HandlerFunc) http.HandlerFunc {
+	return withBodyLimitOf(h.maxBodyBytes, next)
+}
+
+func withBodyLimitOf(limit int64, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > limit {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next(w, r)
+	}
+}
+
+// writeDecodeError answers 413 when the body went over the configured limit
+// and 400 for any other decoding problem.
+func writeDecodeError(w http.ResponseWriter, r *http.Request, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		logging.FromContext(r.Context()).Warn("request body too large", "limit", maxBytesErr.Limit)
+		return
+	}
+	w.WriteHeader(http.StatusBadRequest)
+	logging.FromContext(r.Context()).Warn("data decoder error", "error", err)
+}
+
+// clientIP uses the last X-Forwarded-For entry, the one appended by the
+// load balancer in front of Cloud Run, when proxies are trusted.
+func clientIP(r *http.Request, trustProxy bool) string {
+	if trustProxy {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			parts := strings.Split(forwarded, ",")
+			return strings.TrimSpace(parts[len(parts)-1])
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,93 @@
+package webserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/infra/config"
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+func TestRateLimiterRefills(t *testing.T) {
+	limiter := newRateLimiter(config.RateBudget{RequestsPerMinute: 60, Burst: 2})
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if _, ok := limiter.reserve("key", now); !ok {
+			t.Fatalf("expected request %d to be allowed", i+1)
+		}
+	}
+
+	wait, ok := limiter.reserve("key", now)
+	if ok {
+		t.Fatal("expected third request to be limited")
+	}
+	if wait <= 0 || wait > time.Second {
+		t.Errorf("expected to wait up to 1s, got %s", wait)
+	}
+
+	if _, ok := limiter.reserve("other", now); !ok {
+		t.Error("expected a different key to have its own bucket")
+	}
+
+	if _, ok := limiter.reserve("key", now.Add(time.Second)); !ok {
+		t.Error("expected the bucket to refill after 1s")
+	}
+}
+
+func TestWriteRoutesAreLimitedSeparately(t *testing.T) {
+	cfg := testConfig()
+	cfg.RateLimit.KeyWrite = config.RateBudget{RequestsPerMinute: 1, Burst: 1}
+	server := NewServer(cfg, metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+
+	send := func(method string, path string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer token")
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := send(http.MethodPost, "/talent", talentBody); rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+
+	rec := send(http.MethodPost, "/talent", talentBody)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") != "60" {
+		t.Errorf("expected Retry-After 60, got %s", rec.Header().Get("Retry-After"))
+	}
+
+	if rec := send(http.MethodGet, "/talents", ""); rec.Code != http.StatusOK {
+		t.Errorf("expected reads to keep working, got %d", rec.Code)
+	}
+}
+
+func TestBodyLimit(t *testing.T) {
+	cfg := testConfig()
+	cfg.Server.MaxBodyBytes = 64
+	server := NewServer(cfg, metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+
+	req := httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(talentBody))
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected 413, got %d", rec.Code)
+	}
+
+	// chunked bodies have no Content-Length and are stopped while decoding
+	req = httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(talentBody))
+	req.ContentLength = -1
+	req.Header.Set("Authorization", "Bearer token")
+	rec = httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected 413 for chunked body, got %d", rec.Code)
+	}
+}
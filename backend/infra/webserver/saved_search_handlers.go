@@ -0,0 +1,151 @@
+package webserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+// CreateSavedSearch godoc
+// @Summary Salva uma busca de talentos
+// @Description Guarda os filtros da listagem de talentos com um nome para executá-los novamente. Buscas compartilhadas aparecem para todos, as demais só para o owner, que é apenas um rótulo informado pelo cliente e não uma identidade autenticada. Filtros de pretensão salarial exigem token de recrutador ou admin.
+// @Tags searches
+// @Accept json
+// @Produce json
+// @Param search body usecase.CreateSavedSearchInputDTO true "Dados da busca"
+// @Success 201 {object} usecase.SavedSearchOutputDTO
+// @Header 201 {string} Location "URL para executar a busca"
+// @Failure 400 {string} string "bad request"
+// @Failure 403 {string} string "compensation filters need a recruiter or admin token"
+// @Failure 413 {string} string "request body too large"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /searches [post]
+func (h *Handler) CreateSavedSearch(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateSavedSearchInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	input.CompensationAccess = hasCompensationAccess(r)
+
+	uc := usecase.NewCreateSavedSearchUseCase(r.Context(), h.SavedSearchGateway)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeSavedSearchError(w, r, err)
+		return
+	}
+
+	w.Header().Add("Location", "/searches/"+output.Id+"/talents")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// ListSavedSearches godoc
+// @Summary Lista buscas salvas
+// @Description Retorna as buscas do responsável e as compartilhadas, ordenadas pelo nome. Sem owner, apenas as compartilhadas.
+// @Tags searches
+// @Produce json
+// @Param owner query string false "Responsável. É só um rótulo informado pelo cliente, sem autenticação"
+// @Success 200 {object} usecase.ListSavedSearchesOutputDTO
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /searches [get]
+func (h *Handler) ListSavedSearches(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	uc := usecase.NewListSavedSearchesUseCase(r.Context(), h.SavedSearchGateway)
+	output, err := uc.Execute(usecase.ListSavedSearchesInputDTO{Owner: r.URL.Query().Get("owner")})
+	if err != nil {
+		writeSavedSearchError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// RunSavedSearch godoc
+// @Summary Executa uma busca salva
+// @Description Retorna os talentos que atendem aos filtros da busca, com a mesma paginação de GET /talents.
+// @Tags searches
+// @Produce json
+// @Param id path string true "ID da busca"
+// @Param owner query string false "Responsável. É só um rótulo informado pelo cliente, sem autenticação"
+// @Param limit query int false "Limite de registros por página"
+// @Param cursor query string false "Cursor para próxima página"
+// @Param sort query string false "Ordenação, como em GET /talents"
+// @Success 200 {object} usecase.ListTalentsOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 403 {string} string "compensation filters need a recruiter or admin token"
+// @Failure 404 {string} string "saved search not found or private to another owner"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /searches/{id}/talents [get]
+func (h *Handler) RunSavedSearch(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Add("Vary", "Authorization")
+
+	uc := usecase.NewRunSavedSearchUseCase(r.Context(), h.SavedSearchGateway, h.TalentGateway)
+	output, err := uc.Execute(usecase.RunSavedSearchInputDTO{
+		Id:                 r.PathValue("id"),
+		Owner:              r.URL.Query().Get("owner"),
+		Limit:              parseToInt(r.URL.Query().Get("limit"), 50),
+		Cursor:             r.URL.Query().Get("cursor"),
+		Sort:               r.URL.Query().Get("sort"),
+		CompensationAccess: hasCompensationAccess(r),
+	})
+	if err != nil {
+		writeSavedSearchError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// DeleteSavedSearch godoc
+// @Summary Remove uma busca salva
+// @Tags searches
+// @Param id path string true "ID da busca"
+// @Param owner query string false "Responsável. É só um rótulo informado pelo cliente, sem autenticação"
+// @Success 204 {string} string "no content"
+// @Failure 404 {string} string "saved search not found or private to another owner"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /searches/{id} [delete]
+func (h *Handler) DeleteSavedSearch(w http.ResponseWriter, r *http.Request) {
+	uc := usecase.NewDeleteSavedSearchUseCase(r.Context(), h.SavedSearchGateway)
+	err := uc.Execute(usecase.DeleteSavedSearchInputDTO{Id: r.PathValue("id"), Owner: r.URL.Query().Get("owner")})
+	if err != nil {
+		writeSavedSearchError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeSavedSearchError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrSavedSearchNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Is(err, domain.ErrCompensationForbidden):
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrInvalidSavedSearch), errors.Is(err, domain.ErrInvalidStage), errors.Is(err, domain.ErrInvalidRoleProfile),
+		errors.Is(err, domain.ErrInvalidLocation), errors.Is(err, domain.ErrInvalidCompensation), errors.Is(err, domain.ErrInvalidSort),
+		errors.Is(err, domain.ErrInvalidCursor):
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logging.FromContext(r.Context()).Error("application error", "error", err)
+	}
+}
@@ -0,0 +1,104 @@
+package webserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+func TestSavedSearches(t *testing.T) {
+	handler, talentId := newTestHandlerWithTalent(t)
+
+	rec := httptest.NewRecorder()
+	handler.CreateSavedSearch(rec, httptest.NewRequest(http.MethodPost, "/searches",
+		strings.NewReader(`{"name":"Senior backend","owner":"ana","filter":{"function":"backend","stage":"sourced"}}`)))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	location := rec.Header().Get("Location")
+	handler.CreateSavedSearch(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/searches",
+		strings.NewReader(`{"name":"Frontend","owner":"bruno","shared":true,"filter":{"function":"frontend"}}`)))
+	handler.CreateSavedSearch(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/searches",
+		strings.NewReader(`{"name":"Private","owner":"bruno","filter":{}}`)))
+
+	rec = httptest.NewRecorder()
+	handler.ListSavedSearches(rec, httptest.NewRequest(http.MethodGet, "/searches?owner=ana", nil))
+	var list usecase.ListSavedSearchesOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&list)
+	if len(list.Searches) != 2 || list.Searches[0].Name != "Frontend" || list.Searches[1].Name != "Senior backend" {
+		t.Fatalf("expected ana's and the shared search, got %+v", list.Searches)
+	}
+
+	id := strings.TrimSuffix(strings.TrimPrefix(location, "/searches/"), "/talents")
+	req := httptest.NewRequest(http.MethodGet, location+"?owner=bruno", nil)
+	req.SetPathValue("id", id)
+	rec = httptest.NewRecorder()
+	handler.RunSavedSearch(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for another owner, got %d", rec.Code)
+	}
+	req = httptest.NewRequest(http.MethodDelete, "/searches/"+id+"?owner=bruno", nil)
+	req.SetPathValue("id", id)
+	rec = httptest.NewRecorder()
+	handler.DeleteSavedSearch(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 when another owner deletes, got %d", rec.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, location+"?owner=ana", nil)
+	req.SetPathValue("id", id)
+	rec = httptest.NewRecorder()
+	handler.RunSavedSearch(rec, req)
+	var talents usecase.ListTalentsOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&talents)
+	if rec.Code != http.StatusOK || len(talents.Talents) != 1 || talents.Talents[0].Id != talentId {
+		t.Fatalf("expected the senior backend talent, got %d %+v", rec.Code, talents.Talents)
+	}
+	if !slices.Contains(rec.Header().Values("Vary"), "Authorization") {
+		t.Errorf("expected the results to vary by token, got %v", rec.Header().Values("Vary"))
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, "/searches/"+id+"?owner=ana", nil)
+	req.SetPathValue("id", id)
+	rec = httptest.NewRecorder()
+	handler.DeleteSavedSearch(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", rec.Code)
+	}
+	req = httptest.NewRequest(http.MethodGet, location+"?owner=ana", nil)
+	req.SetPathValue("id", id)
+	rec = httptest.NewRecorder()
+	handler.RunSavedSearch(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 after deleting, got %d", rec.Code)
+	}
+}
+
+func TestCreateSavedSearchValidation(t *testing.T) {
+	handler, _ := newTestHandlerWithTalent(t)
+
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"without name", `{"owner":"ana","filter":{}}`, http.StatusBadRequest},
+		{"without owner", `{"name":"Backend","filter":{}}`, http.StatusBadRequest},
+		{"invalid stage", `{"name":"Backend","owner":"ana","filter":{"stage":"called"}}`, http.StatusBadRequest},
+		{"compensation without access", `{"name":"Budget","owner":"ana","filter":{"compensation":{"currency":"BRL","max":1500000}}}`, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.CreateSavedSearch(rec, httptest.NewRequest(http.MethodPost, "/searches", strings.NewReader(tt.body)))
+			if rec.Code != tt.want {
+				t.Errorf("expected %d, got %d: %s", tt.want, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
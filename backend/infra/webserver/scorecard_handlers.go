@@ -0,0 +1,90 @@
+package webserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+// CreateScorecard godoc
+// @Summary Avalia um talento
+// @Description Registra um scorecard com notas de 1 a 5 por critério, recomendação (strong_no, no, yes, strong_yes) e comentário, opcionalmente para uma vaga. O resumo das avaliações aparece em GET /talent/{id} e permite ordenar GET /talents por rating.
+// @Tags scorecards
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do talento"
+// @Param scorecard body usecase.CreateScorecardInputDTO true "Avaliação"
+// @Success 201 {object} usecase.ScorecardOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 404 {string} string "talent not found"
+// @Failure 413 {string} string "request body too large"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /talent/{id}/scorecards [post]
+func (h *Handler) CreateScorecard(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateScorecardInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	input.TalentId = r.PathValue("id")
+
+	uc := usecase.NewCreateScorecardUseCase(r.Context(), h.TalentGateway, h.ScorecardGateway)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeScorecardError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// ListScorecards godoc
+// @Summary Lista as avaliações de um talento
+// @Description Retorna os scorecards do mais antigo para o mais recente, com a média por critério e o número de avaliações.
+// @Tags scorecards
+// @Produce json
+// @Param id path string true "ID do talento"
+// @Param opening_id query string false "Apenas as avaliações da vaga"
+// @Success 200 {object} usecase.ListScorecardsOutputDTO
+// @Failure 404 {string} string "talent not found"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /talent/{id}/scorecards [get]
+func (h *Handler) ListScorecards(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	uc := usecase.NewListScorecardsUseCase(r.Context(), h.TalentGateway, h.ScorecardGateway)
+	output, err := uc.Execute(usecase.ListScorecardsInputDTO{
+		TalentId:  r.PathValue("id"),
+		OpeningId: r.URL.Query().Get("opening_id"),
+	})
+	if err != nil {
+		writeScorecardError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+func writeScorecardError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidScorecard):
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrTalentNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logging.FromContext(r.Context()).Error("application error", "error", err)
+	}
+}
@@ -0,0 +1,92 @@
+package webserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+func TestScorecards(t *testing.T) {
+	handler, first := newTestHandlerWithTalent(t)
+	rec := httptest.NewRecorder()
+	handler.CreateTalent(rec, httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(strings.Replace(talentBody, "/in/test", "/in/other", 1))))
+	second := strings.TrimPrefix(rec.Header().Get("Location"), "/talent/")
+
+	scorecards := []struct {
+		talentId string
+		body     string
+	}{
+		{first, `{"evaluator":"ana","opening_id":"backend-2026","criteria":[{"name":"Go","score":4},{"name":"Communication","score":3}],"recommendation":"yes"}`},
+		{first, `{"evaluator":"bruno","criteria":[{"name":"go","score":5}],"recommendation":"strong_yes","comment":"great system design"}`},
+		{second, `{"evaluator":"ana","criteria":[{"name":"Go","score":5}],"recommendation":"strong_yes"}`},
+	}
+	for _, scorecard := range scorecards {
+		rec := httptest.NewRecorder()
+		handler.CreateScorecard(rec, taskRequest(http.MethodPost, "/talent/"+scorecard.talentId+"/scorecards", scorecard.talentId, scorecard.body))
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+	}
+
+	rec = httptest.NewRecorder()
+	handler.GetTalent(rec, talentRequest(http.MethodGet, first, ""))
+	var talent usecase.GetTalentOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&talent)
+	summary := talent.Scorecards
+	if summary == nil || summary.Count != 2 || summary.Average != 4.25 || summary.Recommendations["strong_yes"] != 1 {
+		t.Fatalf("unexpected summary %+v", summary)
+	}
+	if len(summary.Criteria) != 2 || summary.Criteria[1].Name != "Go" || summary.Criteria[1].Average != 4.5 || summary.Criteria[1].Count != 2 {
+		t.Errorf("expected the Go scores to be grouped, got %+v", summary.Criteria)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListScorecards(rec, taskRequest(http.MethodGet, "/talent/"+first+"/scorecards?opening_id=backend-2026", first, ""))
+	var list usecase.ListScorecardsOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&list)
+	if len(list.Scorecards) != 1 || list.Summary.Average != 3.5 {
+		t.Errorf("expected only the scorecard of the opening, got %+v", list)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents?sort=-rating", nil))
+	var talents usecase.ListTalentsOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&talents)
+	if len(talents.Talents) != 2 || talents.Talents[0].Id != second || talents.Talents[1].Scorecards.Count != 2 {
+		t.Errorf("expected the best rated talent first, got %+v", talents.Talents)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents?sort=salary", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an unknown sort, got %d", rec.Code)
+	}
+}
+
+func TestCreateScorecardValidation(t *testing.T) {
+	handler, talentId := newTestHandlerWithTalent(t)
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"without evaluator", `{"criteria":[{"name":"Go","score":4}],"recommendation":"yes"}`},
+		{"without criteria", `{"evaluator":"ana","recommendation":"yes"}`},
+		{"score out of range", `{"evaluator":"ana","criteria":[{"name":"Go","score":6}],"recommendation":"yes"}`},
+		{"repeated criterion", `{"evaluator":"ana","criteria":[{"name":"Go","score":4},{"name":"GO","score":3}],"recommendation":"yes"}`},
+		{"unknown recommendation", `{"evaluator":"ana","criteria":[{"name":"Go","score":4}],"recommendation":"maybe"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.CreateScorecard(rec, taskRequest(http.MethodPost, "/talent/"+talentId+"/scorecards", talentId, tt.body))
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d", rec.Code)
+			}
+		})
+	}
+}
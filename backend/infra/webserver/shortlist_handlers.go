@@ -0,0 +1,251 @@
+package webserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+// CreateShortlist godoc
+// @Summary Cria uma shortlist
+// @Description Cria uma lista manual e ordenada de talentos, de até 200 talentos. Shortlists compartilhadas aparecem para todos, as demais só para o owner, que é apenas um rótulo informado pelo cliente e não uma identidade autenticada.
+// @Tags shortlists
+// @Accept json
+// @Produce json
+// @Param shortlist body usecase.CreateShortlistInputDTO true "Dados da shortlist"
+// @Success 201 {object} usecase.ShortlistOutputDTO
+// @Header 201 {string} Location "URL da shortlist recém-criada"
+// @Failure 400 {string} string "bad request"
+// @Failure 413 {string} string "request body too large"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /shortlists [post]
+func (h *Handler) CreateShortlist(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateShortlistInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+
+	uc := usecase.NewCreateShortlistUseCase(r.Context(), h.ShortlistGateway)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeShortlistError(w, r, err)
+		return
+	}
+
+	w.Header().Add("Location", "/shortlists/"+output.Id)
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// ListShortlists godoc
+// @Summary Lista shortlists
+// @Description Retorna as shortlists do responsável e as compartilhadas, ordenadas pelo nome. Sem owner, apenas as compartilhadas.
+// @Tags shortlists
+// @Produce json
+// @Param owner query string false "Responsável. É só um rótulo informado pelo cliente, sem autenticação"
+// @Success 200 {object} usecase.ListShortlistsOutputDTO
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /shortlists [get]
+func (h *Handler) ListShortlists(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	uc := usecase.NewListShortlistsUseCase(r.Context(), h.ShortlistGateway)
+	output, err := uc.Execute(usecase.ListShortlistsInputDTO{Owner: r.URL.Query().Get("owner")})
+	if err != nil {
+		writeShortlistError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// GetShortlist godoc
+// @Summary Busca uma shortlist
+// @Description Retorna a shortlist com os talentos na ordem da lista. Talentos removidos da base ficam apenas em talent_ids.
+// @Tags shortlists
+// @Produce json
+// @Param id path string true "ID da shortlist"
+// @Param owner query string false "Responsável. É só um rótulo informado pelo cliente, sem autenticação"
+// @Success 200 {object} usecase.GetShortlistOutputDTO
+// @Failure 404 {string} string "shortlist not found or private to another owner"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /shortlists/{id} [get]
+func (h *Handler) GetShortlist(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Add("Vary", "Authorization")
+
+	uc := usecase.NewGetShortlistUseCase(r.Context(), h.ShortlistGateway, h.TalentGateway)
+	output, err := uc.Execute(usecase.GetShortlistInputDTO{
+		Id:                 r.PathValue("id"),
+		Owner:              r.URL.Query().Get("owner"),
+		CompensationAccess: hasCompensationAccess(r),
+	})
+	if err != nil {
+		writeShortlistError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// DeleteShortlist godoc
+// @Summary Remove uma shortlist
+// @Tags shortlists
+// @Param id path string true "ID da shortlist"
+// @Param owner query string false "Responsável. É só um rótulo informado pelo cliente, sem autenticação"
+// @Success 204 {string} string "no content"
+// @Failure 404 {string} string "shortlist not found or private to another owner"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /shortlists/{id} [delete]
+func (h *Handler) DeleteShortlist(w http.ResponseWriter, r *http.Request) {
+	uc := usecase.NewDeleteShortlistUseCase(r.Context(), h.ShortlistGateway)
+	err := uc.Execute(usecase.DeleteShortlistInputDTO{Id: r.PathValue("id"), Owner: r.URL.Query().Get("owner")})
+	if err != nil {
+		writeShortlistError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddShortlistTalent godoc
+// @Summary Adiciona um talento a uma shortlist
+// @Description Insere o talento na posição informada (a partir de zero) ou no fim da lista. Talentos que já estão na lista permanecem onde estão.
+// @Tags shortlists
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da shortlist"
+// @Param owner query string false "Responsável. É só um rótulo informado pelo cliente, sem autenticação"
+// @Param talent body usecase.AddShortlistTalentInputDTO true "Talento e posição"
+// @Success 200 {object} usecase.ShortlistOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 404 {string} string "shortlist not found or private to another owner, or talent not found"
+// @Failure 409 {string} string "shortlist changed concurrently"
+// @Failure 413 {string} string "request body too large"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /shortlists/{id}/talents [post]
+func (h *Handler) AddShortlistTalent(w http.ResponseWriter, r *http.Request) {
+	var input usecase.AddShortlistTalentInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	input.Id = r.PathValue("id")
+	input.Owner = r.URL.Query().Get("owner")
+
+	uc := usecase.NewAddShortlistTalentUseCase(r.Context(), h.ShortlistGateway, h.TalentGateway, h.Clock)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeShortlistError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// RemoveShortlistTalent godoc
+// @Summary Remove um talento de uma shortlist
+// @Tags shortlists
+// @Produce json
+// @Param id path string true "ID da shortlist"
+// @Param owner query string false "Responsável. É só um rótulo informado pelo cliente, sem autenticação"
+// @Param talentId path string true "ID do talento"
+// @Success 200 {object} usecase.ShortlistOutputDTO
+// @Failure 404 {string} string "shortlist not found or private to another owner"
+// @Failure 409 {string} string "shortlist changed concurrently"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /shortlists/{id}/talents/{talentId} [delete]
+func (h *Handler) RemoveShortlistTalent(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	uc := usecase.NewRemoveShortlistTalentUseCase(r.Context(), h.ShortlistGateway, h.Clock)
+	output, err := uc.Execute(usecase.RemoveShortlistTalentInputDTO{
+		Id:       r.PathValue("id"),
+		Owner:    r.URL.Query().Get("owner"),
+		TalentId: r.PathValue("talentId"),
+	})
+	if err != nil {
+		writeShortlistError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// ReorderShortlist godoc
+// @Summary Reordena uma shortlist
+// @Description Recebe todos os talentos da shortlist na nova ordem.
+// @Tags shortlists
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da shortlist"
+// @Param owner query string false "Responsável. É só um rótulo informado pelo cliente, sem autenticação"
+// @Param order body usecase.ReorderShortlistInputDTO true "Talentos na nova ordem"
+// @Success 200 {object} usecase.ShortlistOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 404 {string} string "shortlist not found or private to another owner"
+// @Failure 409 {string} string "shortlist changed concurrently"
+// @Failure 413 {string} string "request body too large"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /shortlists/{id}/talents [put]
+func (h *Handler) ReorderShortlist(w http.ResponseWriter, r *http.Request) {
+	var input usecase.ReorderShortlistInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	input.Id = r.PathValue("id")
+	input.Owner = r.URL.Query().Get("owner")
+
+	uc := usecase.NewReorderShortlistUseCase(r.Context(), h.ShortlistGateway, h.Clock)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeShortlistError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+func writeShortlistError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrShortlistNotFound), errors.Is(err, domain.ErrTalentNotFound):
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrShortlistConflict):
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrInvalidShortlist):
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logging.FromContext(r.Context()).Error("application error", "error", err)
+	}
+}
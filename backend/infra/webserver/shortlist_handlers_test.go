@@ -0,0 +1,105 @@
+package webserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+func shortlistRequest(method string, id string, talentId string, body string) *http.Request {
+	return shortlistRequestBy("ana", method, id, talentId, body)
+}
+
+func shortlistRequestBy(owner string, method string, id string, talentId string, body string) *http.Request {
+	req := httptest.NewRequest(method, "/shortlists/"+id+"/talents?owner="+owner, strings.NewReader(body))
+	req.SetPathValue("id", id)
+	req.SetPathValue("talentId", talentId)
+	return req
+}
+
+func TestShortlists(t *testing.T) {
+	handler, first := newTestHandlerWithTalent(t)
+	rec := httptest.NewRecorder()
+	handler.CreateTalent(rec, httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(strings.Replace(talentBody, "/in/test", "/in/other", 1))))
+	second := strings.TrimPrefix(rec.Header().Get("Location"), "/talent/")
+
+	rec = httptest.NewRecorder()
+	handler.CreateShortlist(rec, httptest.NewRequest(http.MethodPost, "/shortlists", strings.NewReader(`{"name":"Backend finalists","owner":"ana"}`)))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	id := strings.TrimPrefix(rec.Header().Get("Location"), "/shortlists/")
+
+	handler.AddShortlistTalent(httptest.NewRecorder(), shortlistRequest(http.MethodPost, id, "", `{"talent_id":"`+first+`"}`))
+	rec = httptest.NewRecorder()
+	handler.AddShortlistTalent(rec, shortlistRequest(http.MethodPost, id, "", `{"talent_id":"`+second+`","position":0}`))
+	var shortlist usecase.ShortlistOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&shortlist)
+	if !slices.Equal(shortlist.TalentIds, []string{second, first}) {
+		t.Fatalf("expected the second talent first, got %v", shortlist.TalentIds)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ReorderShortlist(rec, shortlistRequest(http.MethodPut, id, "", `{"talent_ids":["`+first+`","`+second+`"]}`))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	rec = httptest.NewRecorder()
+	handler.ReorderShortlist(rec, shortlistRequest(http.MethodPut, id, "", `{"talent_ids":["`+first+`"]}`))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an incomplete order, got %d", rec.Code)
+	}
+	rec = httptest.NewRecorder()
+	handler.AddShortlistTalent(rec, shortlistRequest(http.MethodPost, id, "", `{"talent_id":"unknown"}`))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown talent, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.GetShortlist(rec, shortlistRequest(http.MethodGet, id, "", ""))
+	var detail usecase.GetShortlistOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&detail)
+	if len(detail.Talents) != 2 || detail.Talents[0].Id != first || detail.Talents[1].Id != second {
+		t.Fatalf("expected the talents in the new order, got %+v", detail.Talents)
+	}
+	if !slices.Contains(rec.Header().Values("Vary"), "Authorization") {
+		t.Errorf("expected the shortlist to vary by token, got %v", rec.Header().Values("Vary"))
+	}
+
+	rec = httptest.NewRecorder()
+	handler.RemoveShortlistTalent(rec, shortlistRequest(http.MethodDelete, id, first, ""))
+	_ = json.NewDecoder(rec.Body).Decode(&shortlist)
+	if rec.Code != http.StatusOK || !slices.Equal(shortlist.TalentIds, []string{second}) {
+		t.Errorf("expected only the second talent, got %d %v", rec.Code, shortlist.TalentIds)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListShortlists(rec, httptest.NewRequest(http.MethodGet, "/shortlists?owner=bruno", nil))
+	var list usecase.ListShortlistsOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&list)
+	if len(list.Shortlists) != 0 {
+		t.Errorf("expected ana's private shortlist to be hidden, got %+v", list.Shortlists)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.GetShortlist(rec, shortlistRequestBy("bruno", http.MethodGet, id, "", ""))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for another owner, got %d", rec.Code)
+	}
+	rec = httptest.NewRecorder()
+	handler.DeleteShortlist(rec, shortlistRequestBy("bruno", http.MethodDelete, id, "", ""))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 when another owner deletes, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.DeleteShortlist(rec, shortlistRequest(http.MethodDelete, id, "", ""))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("expected 204, got %d", rec.Code)
+	}
+}
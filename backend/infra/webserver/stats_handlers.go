@@ -0,0 +1,65 @@
+package webserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+// GetStats godoc
+// @Summary Estatísticas do banco de talentos
+// @Description Retorna talentos capturados por dia, semana ou mês, as tags, empresas e cargos mais frequentes e o funil por etapa. Talentos arquivados entram em todas as contagens, na etapa em que pararam. Sem from/to, considera os últimos 12 períodos.
+// @Tags stats
+// @Produce json
+// @Param bucket query string false "Agrupamento: day, week ou month (padrão week)"
+// @Param from query string false "Início do período, inclusivo (RFC 3339 ou AAAA-MM-DD)"
+// @Param to query string false "Fim do período, exclusivo (RFC 3339 ou AAAA-MM-DD)"
+// @Param top query int false "Quantidade de itens nos rankings (padrão 10, máximo 50)"
+// @Success 200 {object} usecase.GetStatsOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /stats [get]
+func (h *Handler) GetStats(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	query := r.URL.Query()
+
+	input := usecase.GetStatsInputDTO{
+		Bucket: query.Get("bucket"),
+		Top:    parseToInt(query.Get("top"), 0),
+	}
+	for name, target := range map[string]*time.Time{"from": &input.From, "to": &input.To} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := parseDate(value)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(name + " must be RFC 3339 or YYYY-MM-DD"))
+			return
+		}
+		*target = parsed
+	}
+
+	uc := usecase.NewGetStatsUseCase(r.Context(), h.StatsGateway, h.Clock)
+	output, err := uc.Execute(input)
+	if errors.Is(err, domain.ErrInvalidStatsQuery) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		logging.FromContext(r.Context()).Error("application error", "error", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
@@ -0,0 +1,41 @@
+package webserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+func TestGetStats(t *testing.T) {
+	handler, _ := newTestHandlerWithTalent(t)
+
+	rec := httptest.NewRecorder()
+	handler.GetStats(rec, httptest.NewRequest(http.MethodGet, "/stats?bucket=day&top=5", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	var output usecase.GetStatsOutputDTO
+	err := json.NewDecoder(rec.Body).Decode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output.Total != 1 || len(output.Captured) != 12 {
+		t.Errorf("expected 1 talent over 12 daily buckets, got %d over %d", output.Total, len(output.Captured))
+	}
+}
+
+func TestGetStatsBadRequest(t *testing.T) {
+	handler, _ := newTestHandlerWithTalent(t)
+
+	for _, target := range []string{"/stats?bucket=year", "/stats?from=yesterday", "/stats?from=2024-02-01&to=2024-01-01"} {
+		rec := httptest.NewRecorder()
+		handler.GetStats(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", target, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package webserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+// ListTags godoc
+// @Summary Lista tags do catálogo
+// @Description Retorna as tags mais usadas cujo nome começa com q, para autocomplete. Hífens, espaços e maiúsculas são ignorados na comparação.
+// @Tags tags
+// @Produce json
+// @Param q query string false "Início do nome da tag"
+// @Param category query string false "Categoria: language, framework, domain ou seniority"
+// @Param limit query int false "Limite de registros (padrão 20, máximo 100)"
+// @Success 200 {object} usecase.ListTagsOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /tags [get]
+func (h *Handler) ListTags(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	query := r.URL.Query()
+
+	uc := usecase.NewListTagsUseCase(r.Context(), h.TagGateway)
+	output, err := uc.Execute(usecase.ListTagsInputDTO{
+		Query:    query.Get("q"),
+		Category: query.Get("category"),
+		Limit:    parseToInt(query.Get("limit"), 20),
+	})
+	if err != nil {
+		writeTagError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// CreateTag godoc
+// @Summary Cadastra uma tag
+// @Description Cadastra uma tag canônica com categoria e sinônimos. Talentos cadastrados com um sinônimo passam a usar o nome canônico.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param tag body usecase.CreateTagInputDTO true "Nome, categoria (language, framework, domain ou seniority) e sinônimos"
+// @Success 201 {object} usecase.TagOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 409 {string} string "name or alias already used by another tag"
+// @Failure 413 {string} string "request body too large"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /tags [post]
+func (h *Handler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateTagInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+
+	uc := usecase.NewCreateTagUseCase(r.Context(), h.TagGateway)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeTagError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// UpdateTag godoc
+// @Summary Altera uma tag
+// @Description Substitui nome, categoria e sinônimos. O nome anterior passa a ser um sinônimo.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da tag"
+// @Param tag body usecase.UpdateTagInputDTO true "Nome, categoria e sinônimos"
+// @Success 200 {object} usecase.TagOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 404 {string} string "tag not found"
+// @Failure 409 {string} string "name or alias already used by another tag"
+// @Failure 413 {string} string "request body too large"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /tags/{id} [put]
+func (h *Handler) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdateTagInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	input.Id = r.PathValue("id")
+
+	uc := usecase.NewUpdateTagUseCase(r.Context(), h.TagGateway)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeTagError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// SuggestTags godoc
+// @Summary Sugere tags para um perfil
+// @Description Retorna as tags do catálogo encontradas no headline e nos cargos, em português ou inglês, sem salvar nada. São as mesmas usadas ao cadastrar um talento sem tags.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param profile body usecase.SuggestTagsInputDTO true "Headline e cargos"
+// @Success 200 {object} usecase.SuggestTagsOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 413 {string} string "request body too large"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /tags/suggest [post]
+func (h *Handler) SuggestTags(w http.ResponseWriter, r *http.Request) {
+	var input usecase.SuggestTagsInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+
+	uc := usecase.NewSuggestTagsUseCase(r.Context(), h.TagSuggester)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeTagError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// MergeTag godoc
+// @Summary Mescla uma tag em outra
+// @Description O nome e os sinônimos da tag passam a ser sinônimos da tag de destino, os talentos que a usam são reescritos em lotes e a tag é removida. Pode ser repetido se for interrompido.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da tag a ser mesclada"
+// @Param merge body usecase.MergeTagInputDTO true "ID da tag de destino"
+// @Success 200 {object} usecase.MergeTagOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 401 {string} string "unauthorized"
+// @Failure 404 {string} string "tag not found"
+// @Failure 413 {string} string "request body too large"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /tags/{id}/merge [post]
+func (h *Handler) MergeTag(w http.ResponseWriter, r *http.Request) {
+	var input usecase.MergeTagInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	input.Id = r.PathValue("id")
+
+	uc := usecase.NewMergeTagUseCase(r.Context(), h.TagGateway, h.TalentGateway)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeTagError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+func writeTagError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidTag):
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrTagConflict):
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrTagNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logging.FromContext(r.Context()).Error("application error", "error", err)
+	}
+}
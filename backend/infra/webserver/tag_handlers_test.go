@@ -0,0 +1,114 @@
+package webserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/usecase"
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+func TestTagCatalog(t *testing.T) {
+	talents := NewInMemoryTalentGateway()
+	cfg := testConfig()
+	server := NewServer(cfg, metrics.New(), testDependencies(talents, NewInMemoryIdempotencyGateway()))
+	send := func(method string, path string, body string, token string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, adminRequest(method, path, body, token))
+		return rec
+	}
+
+	rec := send(http.MethodPost, "/tags", `{"name":"Go","category":"language","aliases":["golang"]}`, "token")
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+	var goTag usecase.TagOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&goTag)
+
+	if rec = send(http.MethodPost, "/tags", `{"name":"go-lang"}`, "token"); rec.Code != http.StatusConflict {
+		t.Errorf("expected 409 for a name used as alias, got %d", rec.Code)
+	}
+	if rec = send(http.MethodPost, "/tags", `{"name":"Elixir","category":"hobby"}`, "token"); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an unknown category, got %d", rec.Code)
+	}
+
+	body := strings.TrimSuffix(talentBody, "}") + `,"tags":["GoLang","gopher"]}`
+	if rec = send(http.MethodPost, "/talent", body, "token"); rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+
+	rec = send(http.MethodGet, "/tags?q=go", "", "token")
+	var list usecase.ListTagsOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&list)
+	if len(list.Tags) != 2 {
+		t.Fatalf("expected Go and the registered gopher tag, got %v", list.Tags)
+	}
+	var gopher usecase.TagOutputDTO
+	for _, tag := range list.Tags {
+		if tag.Name == "gopher" {
+			gopher = tag
+		}
+	}
+
+	merge := `{"into":"` + goTag.Id + `"}`
+	if rec = send(http.MethodPost, "/tags/"+gopher.Id+"/merge", merge, "token"); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected merge to require the admin token, got %d", rec.Code)
+	}
+	rec = send(http.MethodPost, "/tags/"+gopher.Id+"/merge", merge, "admin")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	var merged usecase.MergeTagOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&merged)
+	if merged.TalentsUpdated != 1 || merged.Tag.UsageCount != 1 {
+		t.Errorf("expected one talent rewritten and Go used once, got %+v", merged)
+	}
+	for _, talent := range talents.talents {
+		if len(talent.Tags) != 1 || talent.Tags[0] != "Go" {
+			t.Errorf("expected the talent tags to be [Go], got %v", talent.Tags)
+		}
+	}
+
+	if rec = send(http.MethodPost, "/tags/"+gopher.Id+"/merge", merge, "admin"); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for a merged tag, got %d", rec.Code)
+	}
+}
+
+func TestSuggestTags(t *testing.T) {
+	server := NewServer(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+	send := func(method string, path string, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, adminRequest(method, path, body, "token"))
+		return rec
+	}
+
+	if rec := send(http.MethodPost, "/tags", `{"name":"Kubernetes","aliases":["k8s"]}`); rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+	if rec := send(http.MethodPost, "/tags", `{"name":"Senior","category":"seniority"}`); rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+
+	rec := send(http.MethodPost, "/tags/suggest", `{"headline":"Desenvolvedor Sênior | K8s","current_role":"SRE"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	var output usecase.SuggestTagsOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&output)
+	if strings.Join(output.Tags, ",") != "Senior,Kubernetes" {
+		t.Errorf("expected [Senior Kubernetes], got %v", output.Tags)
+	}
+
+	rec = send(http.MethodPost, "/talent", talentBody)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+	var created CreateTalentResponse
+	_ = json.NewDecoder(rec.Body).Decode(&created)
+	if created.Value != rec.Header().Get("Location") || strings.Join(created.SuggestedTags, ",") != "Senior" {
+		t.Errorf("expected the applied suggestions [Senior] in the body, got %+v", created)
+	}
+}
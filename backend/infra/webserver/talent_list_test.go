@@ -0,0 +1,57 @@
+package webserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/usecase"
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+func TestListTalentsSorted(t *testing.T) {
+	handler := NewHandler(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+	talents := []struct{ name, company string }{{"Carla", "Acme"}, {"Ana", "Zeta"}, {"Bruno", "Acme"}}
+	for i, talent := range talents {
+		body := fmt.Sprintf(`{"profile_url":"https://linkedin.com/in/%d","possible_role":"Backend Engineer","full_name":%q,"headline":"Developer","current_company":%q}`,
+			i, talent.name, talent.company)
+		rec := httptest.NewRecorder()
+		handler.CreateTalent(rec, httptest.NewRequest(http.MethodPost, "/talent", strings.NewReader(body)))
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected 201, got %d", rec.Code)
+		}
+	}
+
+	var names []string
+	path := "/talents?sort=current_company,-full_name&limit=2"
+	for range talents {
+		rec := httptest.NewRecorder()
+		handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		var output usecase.ListTalentsOutputDTO
+		_ = json.NewDecoder(rec.Body).Decode(&output)
+		for _, talent := range output.Talents {
+			names = append(names, talent.FullName)
+		}
+		if output.NextCursor == "" {
+			break
+		}
+		path = "/talents?sort=current_company,-full_name&limit=2&cursor=" + output.NextCursor
+	}
+	if !slices.Equal(names, []string{"Carla", "Bruno", "Ana"}) {
+		t.Errorf("expected the talents by company and then name descending, got %v", names)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents?sort=full_name&limit=1", nil))
+	var output usecase.ListTalentsOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&output)
+	rec = httptest.NewRecorder()
+	handler.ListTalents(rec, httptest.NewRequest(http.MethodGet, "/talents?sort=-updated_at&cursor="+output.NextCursor, nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for a cursor of another sort, got %d", rec.Code)
+	}
+}
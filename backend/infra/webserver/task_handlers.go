@@ -0,0 +1,165 @@
+package webserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+// CreateTask godoc
+// @Summary Cria um follow-up para um talento
+// @Description Agenda uma tarefa de follow-up com responsável, data limite e observação.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do talento"
+// @Param task body usecase.CreateTaskInputDTO true "Dados da tarefa"
+// @Success 201 {object} usecase.TaskOutputDTO
+// @Header 201 {string} Location "URL da tarefa recém-criada"
+// @Failure 400 {string} string "bad request"
+// @Failure 404 {string} string "talent not found"
+// @Failure 413 {string} string "request body too large"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /talent/{id}/tasks [post]
+func (h *Handler) CreateTask(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateTaskInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	input.TalentId = r.PathValue("id")
+
+	uc := usecase.NewCreateTaskUseCase(r.Context(), h.TalentGateway, h.TaskGateway)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeTaskError(w, r, err)
+		return
+	}
+
+	w.Header().Add("Location", "/tasks/"+output.Id)
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// ListTasks godoc
+// @Summary Lista follow-ups
+// @Description Retorna as tarefas de follow-up ordenadas pela data limite.
+// @Tags tasks
+// @Produce json
+// @Param assignee query string false "Responsável"
+// @Param due_before query string false "Data limite máxima (RFC 3339 ou AAAA-MM-DD)"
+// @Param talent_id query string false "ID do talento"
+// @Param done query bool false "Filtra tarefas concluídas ou abertas"
+// @Param limit query int false "Limite de registros (padrão 50, máximo 200)"
+// @Success 200 {object} usecase.ListTasksOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /tasks [get]
+func (h *Handler) ListTasks(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	query := r.URL.Query()
+
+	input := usecase.ListTasksInputDTO{
+		TalentId: query.Get("talent_id"),
+		Assignee: query.Get("assignee"),
+		Limit:    parseToInt(query.Get("limit"), 50),
+	}
+	if value := query.Get("due_before"); value != "" {
+		dueBefore, err := parseDate(value)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("due_before must be RFC 3339 or YYYY-MM-DD"))
+			return
+		}
+		input.DueBefore = dueBefore
+	}
+	if value := query.Get("done"); value != "" {
+		done, err := strconv.ParseBool(value)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte("done must be true or false"))
+			return
+		}
+		input.Done = &done
+	}
+
+	uc := usecase.NewListTasksUseCase(r.Context(), h.TaskGateway)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeTaskError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// UpdateTask godoc
+// @Summary Altera um follow-up
+// @Description Altera apenas os campos enviados. Use done=true para concluir a tarefa.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da tarefa"
+// @Param task body usecase.UpdateTaskInputDTO true "Campos a alterar"
+// @Success 200 {object} usecase.TaskOutputDTO
+// @Failure 400 {string} string "bad request"
+// @Failure 404 {string} string "task not found"
+// @Failure 413 {string} string "request body too large"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /tasks/{id} [patch]
+func (h *Handler) UpdateTask(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdateTaskInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	input.Id = r.PathValue("id")
+
+	uc := usecase.NewUpdateTaskUseCase(r.Context(), h.TaskGateway, h.Clock)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeTaskError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// parseDate accepts a full timestamp or a plain date, read as midnight UTC.
+func parseDate(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
+
+func writeTaskError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidTask):
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrTaskNotFound), errors.Is(err, domain.ErrTalentNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logging.FromContext(r.Context()).Error("application error", "error", err)
+	}
+}
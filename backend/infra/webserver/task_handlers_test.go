@@ -0,0 +1,66 @@
+package webserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+func taskRequest(method string, path string, id string, body string) *http.Request {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.SetPathValue("id", id)
+	return req
+}
+
+func TestCreateAndListTasks(t *testing.T) {
+	handler, talentId := newTestHandlerWithTalent(t)
+
+	rec := httptest.NewRecorder()
+	handler.CreateTask(rec, taskRequest(http.MethodPost, "/talent/"+talentId+"/tasks", talentId, `{"assignee":"ana","note":"send the offer","due_at":"2026-03-10T12:00:00Z"}`))
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+	handler.CreateTask(httptest.NewRecorder(), taskRequest(http.MethodPost, "/talent/"+talentId+"/tasks", talentId, `{"assignee":"bruno","due_at":"2026-03-20T12:00:00Z"}`))
+
+	rec = httptest.NewRecorder()
+	handler.ListTasks(rec, httptest.NewRequest(http.MethodGet, "/tasks?assignee=ana&due_before=2026-03-11", nil))
+	var output usecase.ListTasksOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&output)
+	if len(output.Tasks) != 1 || output.Tasks[0].Note != "send the offer" {
+		t.Fatalf("expected ana's task only, got %+v", output.Tasks)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.UpdateTask(rec, taskRequest(http.MethodPatch, "/tasks/"+output.Tasks[0].Id, output.Tasks[0].Id, `{"done":true}`))
+	var updated usecase.TaskOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&updated)
+	if rec.Code != http.StatusOK || !updated.Done || updated.CompletedAt == nil {
+		t.Errorf("expected the task to be completed, got %d %+v", rec.Code, updated)
+	}
+}
+
+func TestCreateTaskValidation(t *testing.T) {
+	handler, talentId := newTestHandlerWithTalent(t)
+
+	rec := httptest.NewRecorder()
+	handler.CreateTask(rec, taskRequest(http.MethodPost, "/talent/unknown/tasks", "unknown", `{"assignee":"ana","due_at":"2026-03-10T12:00:00Z"}`))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown talent, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.CreateTask(rec, taskRequest(http.MethodPost, "/talent/"+talentId+"/tasks", talentId, `{"due_at":"2026-03-10T12:00:00Z"}`))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 without assignee, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ListTasks(rec, httptest.NewRequest(http.MethodGet, "/tasks?due_before=tomorrow", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 for an invalid due_before, got %d", rec.Code)
+	}
+}
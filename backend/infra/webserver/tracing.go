@@ -0,0 +1,70 @@
+package webserver
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const traceIDHeader = "X-Trace-Id"
+
+var untracedPaths = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+// withTracing starts a server span for each request, continuing the trace
+// from incoming traceparent headers.
+func withTracing(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(withTraceID(next), "http.request",
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return !untracedPaths[r.URL.Path]
+		}),
+	)
+}
+
+// withRouteName renames the server span after the matched route. It must
+// wrap the mux directly since that is the request the mux sets Pattern on.
+func withRouteName(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r)
+		if r.Pattern == "" {
+			return
+		}
+		span := trace.SpanFromContext(r.Context())
+		span.SetName(r.Pattern)
+		span.SetAttributes(attribute.String("http.route", r.Pattern))
+	})
+}
+
+func withTraceID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		spanContext := trace.SpanContextFromContext(r.Context())
+		if !spanContext.HasTraceID() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(&traceIDWriter{ResponseWriter: w, traceID: spanContext.TraceID().String()}, r)
+	})
+}
+
+// traceIDWriter echoes the trace id on error responses so a failure reported
+// by a client can be found in the tracing backend.
+type traceIDWriter struct {
+	http.ResponseWriter
+	traceID string
+}
+
+func (w *traceIDWriter) WriteHeader(status int) {
+	if status >= http.StatusBadRequest {
+		w.Header().Set(traceIDHeader, w.traceID)
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *traceIDWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
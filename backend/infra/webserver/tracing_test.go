@@ -0,0 +1,70 @@
+package webserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previousProvider := otel.GetTracerProvider()
+	previousPropagator := otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+	return recorder
+}
+
+func TestTracingContinuesIncomingTrace(t *testing.T) {
+	recorder := recordSpans(t)
+	server := NewServer(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+
+	traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+	req := httptest.NewRequest(http.MethodGet, "/talent/missing", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+
+	if rec.Header().Get(traceIDHeader) != traceID {
+		t.Errorf("expected trace id %s in error response, got %q", traceID, rec.Header().Get(traceIDHeader))
+	}
+
+	names := make(map[string]bool)
+	for _, span := range recorder.Ended() {
+		if span.SpanContext().TraceID().String() != traceID {
+			t.Errorf("expected span %s to belong to trace %s", span.Name(), traceID)
+		}
+		names[span.Name()] = true
+	}
+	for _, expected := range []string{"GET /talent/{id}", "GetTalentUseCase.Execute"} {
+		if !names[expected] {
+			t.Errorf("expected span %s, got %v", expected, names)
+		}
+	}
+}
+
+func TestTracingSkipsHealthChecks(t *testing.T) {
+	recorder := recordSpans(t)
+	server := NewServer(testConfig(), metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+
+	server.Handler().ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	if len(recorder.Ended()) != 0 {
+		t.Errorf("expected no spans for /healthz, got %d", len(recorder.Ended()))
+	}
+}
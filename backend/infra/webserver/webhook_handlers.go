@@ -0,0 +1,196 @@
+package webserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/logging"
+	"github.com/allanCordeiro/talent-db/application/usecase"
+)
+
+// CreateWebhook godoc
+// @Summary Cadastra um webhook
+// @Description Registra uma URL que receberá os eventos de talentos assinados com HMAC-SHA256. O segredo só é devolvido nesta resposta.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param webhook body usecase.CreateWebhookInputDTO true "URL, eventos (talent.created, talent.updated, talent.stage_changed, talent.archived, talent.deleted, task.overdue, task.digest ou *) e segredo opcional"
+// @Success 201 {object} usecase.CreateWebhookOutputDTO
+// @Header 201 {string} Location "URL do webhook recém-criado"
+// @Failure 400 {string} string "bad request"
+// @Failure 401 {string} string "unauthorized"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /admin/webhooks [post]
+func (h *Handler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateWebhookInputDTO
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+
+	uc := usecase.NewCreateWebhookUseCase(r.Context(), h.WebhookGateway)
+	output, err := uc.Execute(input)
+	if err != nil {
+		writeWebhookError(w, r, err)
+		return
+	}
+
+	w.Header().Add("Location", "/admin/webhooks/"+output.Id)
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// ListWebhooks godoc
+// @Summary Lista webhooks
+// @Description Retorna os webhooks cadastrados, sem o segredo.
+// @Tags webhooks
+// @Produce json
+// @Success 200 {object} usecase.ListWebhooksOutputDTO
+// @Failure 401 {string} string "unauthorized"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /admin/webhooks [get]
+func (h *Handler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	uc := usecase.NewListWebhooksUseCase(r.Context(), h.WebhookGateway)
+	output, err := uc.Execute()
+	if err != nil {
+		writeWebhookError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// UpdateWebhook godoc
+// @Summary Atualiza um webhook
+// @Description Substitui URL, eventos e status de um webhook. O segredo é mantido.
+// @Tags webhooks
+// @Accept json
+// @Param id path string true "ID do webhook"
+// @Param webhook body usecase.UpdateWebhookInputDTO true "Dados do webhook"
+// @Success 204 {string} string "updated"
+// @Failure 400 {string} string "bad request"
+// @Failure 401 {string} string "unauthorized"
+// @Failure 404 {string} string "webhook not found"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /admin/webhooks/{id} [put]
+func (h *Handler) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdateWebhookInputDTO
+	err := json.NewDecoder(r.Body).Decode(&input)
+	if err != nil {
+		writeDecodeError(w, r, err)
+		return
+	}
+	input.Id = r.PathValue("id")
+
+	uc := usecase.NewUpdateWebhookUseCase(r.Context(), h.WebhookGateway)
+	err = uc.Execute(input)
+	if err != nil {
+		writeWebhookError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteWebhook godoc
+// @Summary Remove um webhook
+// @Description Remove um webhook. Entregas pendentes dele vão para a lista de dead letter.
+// @Tags webhooks
+// @Param id path string true "ID do webhook"
+// @Success 204 {string} string "deleted"
+// @Failure 401 {string} string "unauthorized"
+// @Failure 404 {string} string "webhook not found"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /admin/webhooks/{id} [delete]
+func (h *Handler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	uc := usecase.NewDeleteWebhookUseCase(r.Context(), h.WebhookGateway)
+	err := uc.Execute(usecase.DeleteWebhookInputDTO{
+		Id: r.PathValue("id"),
+	})
+	if err != nil {
+		writeWebhookError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListWebhookDeliveries godoc
+// @Summary Lista entregas de webhooks
+// @Description Retorna o histórico de entregas mais recentes. Use status=dead para ver a lista de dead letter.
+// @Tags webhooks
+// @Produce json
+// @Param subscription_id query string false "ID do webhook"
+// @Param status query string false "pending, delivered ou dead"
+// @Param limit query int false "Limite de registros (padrão 50, máximo 200)"
+// @Success 200 {object} usecase.ListWebhookDeliveriesOutputDTO
+// @Failure 401 {string} string "unauthorized"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /admin/webhooks/deliveries [get]
+func (h *Handler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	uc := usecase.NewListWebhookDeliveriesUseCase(r.Context(), h.WebhookGateway)
+	output, err := uc.Execute(usecase.ListWebhookDeliveriesInputDTO{
+		SubscriptionId: r.URL.Query().Get("subscription_id"),
+		Status:         r.URL.Query().Get("status"),
+		Limit:          parseToInt(r.URL.Query().Get("limit"), 50),
+	})
+	if err != nil {
+		writeWebhookError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(output)
+}
+
+// RetryWebhookDelivery godoc
+// @Summary Reenvia uma entrega de webhook
+// @Description Coloca a entrega de volta na fila com as tentativas zeradas. Serve para esvaziar a lista de dead letter.
+// @Tags webhooks
+// @Param id path string true "ID da entrega"
+// @Success 202 {string} string "accepted"
+// @Failure 401 {string} string "unauthorized"
+// @Failure 404 {string} string "delivery not found"
+// @Failure 429 {string} string "too many requests"
+// @Failure 500 {string} string "internal error"
+// @Router /admin/webhooks/deliveries/{id}/retry [post]
+func (h *Handler) RetryWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	uc := usecase.NewRetryWebhookDeliveryUseCase(r.Context(), h.WebhookGateway, h.WebhookQueue)
+	err := uc.Execute(usecase.RetryWebhookDeliveryInputDTO{
+		Id: r.PathValue("id"),
+	})
+	if err != nil {
+		writeWebhookError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func writeWebhookError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidWebhook):
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+	case errors.Is(err, domain.ErrWebhookNotFound), errors.Is(err, domain.ErrWebhookDeliveryNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+		logging.FromContext(r.Context()).Error("application error", "error", err)
+	}
+}
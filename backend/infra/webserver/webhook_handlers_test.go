@@ -0,0 +1,120 @@
+package webserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/usecase"
+	"github.com/allanCordeiro/talent-db/infra/metrics"
+)
+
+func adminRequest(method string, path string, body string, token string) *http.Request {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	return req
+}
+
+func TestWebhookRoutesRequireAdminToken(t *testing.T) {
+	cfg := testConfig()
+	server := NewServer(cfg, metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, adminRequest(http.MethodGet, "/admin/webhooks", "", "token"))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected 401 with the API token, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, adminRequest(http.MethodGet, "/admin/webhooks", "", "admin"))
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200 with the admin token, got %d", rec.Code)
+	}
+
+	cfg.Server.AdminToken = ""
+	server = NewServer(cfg, metrics.New(), testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway()))
+	rec = httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, adminRequest(http.MethodGet, "/admin/webhooks", "", "token"))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected the admin routes to be disabled without an admin token, got %d", rec.Code)
+	}
+}
+
+func TestWebhookCRUD(t *testing.T) {
+	deps := testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway())
+	server := NewServer(testConfig(), metrics.New(), deps)
+	send := func(method string, path string, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, adminRequest(method, path, body, "admin"))
+		return rec
+	}
+
+	rec := send(http.MethodPost, "/admin/webhooks", `{"url":"ftp://example.com","events":["*"]}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for an invalid url, got %d", rec.Code)
+	}
+
+	rec = send(http.MethodPost, "/admin/webhooks", `{"url":"https://example.com/hook","events":["talent.created"]}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d", rec.Code)
+	}
+	var created usecase.CreateWebhookOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&created)
+	if created.Secret == "" {
+		t.Error("expected the secret to be returned on creation")
+	}
+
+	rec = send(http.MethodGet, "/admin/webhooks", "")
+	if strings.Contains(rec.Body.String(), created.Secret) {
+		t.Error("expected the secret not to be listed")
+	}
+
+	rec = send(http.MethodPut, "/admin/webhooks/"+created.Id, `{"url":"https://example.com/other","events":["*"],"active":false}`)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rec.Code)
+	}
+	saved, _ := deps.WebhookGateway.GetSubscriptionById(context.Background(), created.Id)
+	if saved.Active || saved.URL != "https://example.com/other" || saved.Secret != created.Secret {
+		t.Errorf("unexpected subscription after update %+v", saved)
+	}
+
+	if rec = send(http.MethodDelete, "/admin/webhooks/"+created.Id, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rec.Code)
+	}
+	if rec = send(http.MethodDelete, "/admin/webhooks/"+created.Id, ""); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404, got %d", rec.Code)
+	}
+}
+
+func TestRetryWebhookDeliveryRequeuesDeadLetter(t *testing.T) {
+	deps := testDependencies(NewInMemoryTalentGateway(), NewInMemoryIdempotencyGateway())
+	server := NewServer(testConfig(), metrics.New(), deps)
+
+	delivery := domain.NewWebhookDelivery("sub", "event", domain.EventTalentCreated, []byte("{}"))
+	delivery.Status = domain.DeliveryDead
+	delivery.Attempts = 6
+	_ = deps.WebhookGateway.SaveDelivery(context.Background(), *delivery)
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, adminRequest(http.MethodGet, "/admin/webhooks/deliveries?status=dead", "", "admin"))
+	var list usecase.ListWebhookDeliveriesOutputDTO
+	_ = json.NewDecoder(rec.Body).Decode(&list)
+	if len(list.Deliveries) != 1 {
+		t.Fatalf("expected 1 dead delivery, got %d", len(list.Deliveries))
+	}
+
+	rec = httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, adminRequest(http.MethodPost, "/admin/webhooks/deliveries/"+delivery.Id.String()+"/retry", "", "admin"))
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d", rec.Code)
+	}
+
+	queue := deps.WebhookQueue.(*RecordingWebhookQueue)
+	if len(queue.enqueued) != 1 || queue.enqueued[0].Status != domain.DeliveryPending || queue.enqueued[0].Attempts != 0 {
+		t.Errorf("expected the delivery to be requeued, got %+v", queue.enqueued)
+	}
+}
@@ -1,31 +1,124 @@
 package webserver
 
 import (
-	"log"
+	"context"
+	"errors"
+	"log/slog"
+	"net"
 	"net/http"
-	"os"
+	"strconv"
+	"time"
 
-	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/infra/config"
+	"github.com/allanCordeiro/talent-db/infra/metrics"
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
-func Serve(talentGateway domain.TalentGateway) {
-	port := os.Getenv("SERVER_PORT")
-	if port == "" {
-		port = "8080"
+type Server struct {
+	httpServer      *http.Server
+	shutdownTimeout time.Duration
+}
+
+func NewServer(cfg config.Config, m *metrics.Metrics, deps Dependencies) *Server {
+	handler := NewHandler(cfg, m, deps)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /talent", handler.protect(handler.withIdempotency(handler.CreateTalent)))
+	mux.HandleFunc("GET /talent/{id}", handler.protect(handler.GetTalent))
+	mux.HandleFunc("PUT /talent/{id}", handler.protect(handler.UpdateTalent))
+	mux.HandleFunc("PATCH /talent/{id}", handler.protect(handler.PatchTalent))
+	mux.HandleFunc("DELETE /talent/{id}", handler.protect(handler.DeleteTalent))
+	mux.HandleFunc("POST /talent/{id}/archive", handler.protect(handler.ArchiveTalent))
+	mux.HandleFunc("GET /talents", handler.protect(handler.ListTalents))
+	mux.HandleFunc("POST /talent/{id}/attachments", handler.protectUpload(handler.UploadAttachment))
+	mux.HandleFunc("GET /talent/{id}/attachments", handler.protect(handler.ListAttachments))
+	mux.HandleFunc("GET /talent/{id}/attachments/{attachmentId}", handler.protect(handler.DownloadAttachment))
+	mux.HandleFunc("GET /talent/{id}/attachments/{attachmentId}/text", handler.protect(handler.GetAttachmentText))
+	mux.HandleFunc("POST /talent/{id}/tasks", handler.protect(handler.CreateTask))
+	mux.HandleFunc("POST /talent/{id}/scorecards", handler.protect(handler.CreateScorecard))
+	mux.HandleFunc("GET /talent/{id}/scorecards", handler.protect(handler.ListScorecards))
+	mux.HandleFunc("GET /tasks", handler.protect(handler.ListTasks))
+	mux.HandleFunc("PATCH /tasks/{id}", handler.protect(handler.UpdateTask))
+	mux.HandleFunc("POST /searches", handler.protect(handler.CreateSavedSearch))
+	mux.HandleFunc("GET /searches", handler.protect(handler.ListSavedSearches))
+	mux.HandleFunc("GET /searches/{id}/talents", handler.protect(handler.RunSavedSearch))
+	mux.HandleFunc("DELETE /searches/{id}", handler.protect(handler.DeleteSavedSearch))
+	mux.HandleFunc("POST /shortlists", handler.protect(handler.CreateShortlist))
+	mux.HandleFunc("GET /shortlists", handler.protect(handler.ListShortlists))
+	mux.HandleFunc("GET /shortlists/{id}", handler.protect(handler.GetShortlist))
+	mux.HandleFunc("DELETE /shortlists/{id}", handler.protect(handler.DeleteShortlist))
+	mux.HandleFunc("POST /shortlists/{id}/talents", handler.protect(handler.AddShortlistTalent))
+	mux.HandleFunc("PUT /shortlists/{id}/talents", handler.protect(handler.ReorderShortlist))
+	mux.HandleFunc("DELETE /shortlists/{id}/talents/{talentId}", handler.protect(handler.RemoveShortlistTalent))
+	mux.HandleFunc("GET /stats", handler.protect(handler.GetStats))
+	mux.HandleFunc("GET /tags", handler.protect(handler.ListTags))
+	mux.HandleFunc("POST /tags", handler.protect(handler.CreateTag))
+	mux.HandleFunc("POST /tags/suggest", handler.protect(handler.SuggestTags))
+	mux.HandleFunc("PUT /tags/{id}", handler.protect(handler.UpdateTag))
+	mux.HandleFunc("POST /tags/{id}/merge", handler.protectAdmin(handler.MergeTag))
+	mux.HandleFunc("POST /admin/webhooks", handler.protectAdmin(handler.CreateWebhook))
+	mux.HandleFunc("GET /admin/webhooks", handler.protectAdmin(handler.ListWebhooks))
+	mux.HandleFunc("PUT /admin/webhooks/{id}", handler.protectAdmin(handler.UpdateWebhook))
+	mux.HandleFunc("DELETE /admin/webhooks/{id}", handler.protectAdmin(handler.DeleteWebhook))
+	mux.HandleFunc("GET /admin/webhooks/deliveries", handler.protectAdmin(handler.ListWebhookDeliveries))
+	mux.HandleFunc("POST /admin/webhooks/deliveries/{id}/retry", handler.protectAdmin(handler.RetryWebhookDelivery))
+	mux.HandleFunc("/swagger/", httpSwagger.WrapHandler)
+	mux.HandleFunc("GET /healthz", handler.Healthz)
+	mux.HandleFunc("GET /readyz", handler.Readyz)
+	mux.HandleFunc("GET /version", handler.Version)
+	mux.Handle("GET /metrics", m.Handler())
+
+	return &Server{
+		httpServer: &http.Server{
+			Addr:              ":" + strconv.Itoa(cfg.Server.Port),
+			Handler:           withTracing(withRequestLogging(withMetrics(m, withCORS(newCORSPolicy(cfg.CORS), withRouteName(mux))))),
+			ReadHeaderTimeout: cfg.Server.ReadHeaderTimeout,
+			ReadTimeout:       cfg.Server.ReadTimeout,
+			WriteTimeout:      cfg.Server.WriteTimeout,
+			IdleTimeout:       cfg.Server.IdleTimeout,
+		},
+		shutdownTimeout: cfg.Server.ShutdownTimeout,
 	}
+}
+
+func (s *Server) Handler() http.Handler {
+	return s.httpServer.Handler
+}
 
-	token := os.Getenv("API_TOKEN")
-	if token == "" {
-		log.Panicf("API_TOKEN environment variable not set")
+// Run listens on the configured port until ctx is cancelled and then drains
+// in-flight requests for at most the configured shutdown timeout.
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, listener)
+}
+
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("starting server", "address", listener.Addr().String())
+		serveErr <- s.httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
 	}
 
-	handler := NewHandler(talentGateway, token)
-	http.HandleFunc("POST /talent", handler.withAuth(handler.CreateTalent))
-	http.HandleFunc("GET /talent/{id}", handler.withAuth(handler.GetTalent))
-	http.HandleFunc("GET /talents", handler.withAuth(handler.ListTalents))
-	http.HandleFunc("/swagger/", httpSwagger.WrapHandler)
+	slog.Info("shutting down server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+	err := s.httpServer.Shutdown(shutdownCtx)
+	if err != nil {
+		return err
+	}
 
-	log.Println("starting server on port " + port)
-	log.Fatal(http.ListenAndServe(":"+port, nil))
+	err = <-serveErr
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
 }
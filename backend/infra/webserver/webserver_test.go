@@ -0,0 +1,536 @@
+package webserver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/allanCordeiro/talent-db/application/domain"
+	"github.com/allanCordeiro/talent-db/application/tagging"
+	"github.com/allanCordeiro/talent-db/infra/config"
+)
+
+func testConfig() config.Config {
+	cfg := config.Default()
+	cfg.Server.APIToken = "token"
+	cfg.Server.AdminToken = "admin"
+	cfg.Idempotency.TTL = time.Hour
+	return cfg
+}
+
+func testDependencies(talents *InMemoryTalentGateway, idempotency *InMemoryIdempotencyGateway) Dependencies {
+	tags := NewInMemoryTagGateway()
+	return Dependencies{
+		TalentGateway:      talents,
+		IdempotencyGateway: idempotency,
+		WebhookGateway:     NewInMemoryWebhookGateway(),
+		WebhookQueue:       &RecordingWebhookQueue{},
+		TaskGateway:        NewInMemoryTaskGateway(),
+		StatsGateway:       talents,
+		TagGateway:         tags,
+		TagSuggester:       tagging.NewSuggester(tags, domain.SystemClock{}, 0),
+		AttachmentGateway:  NewInMemoryAttachmentGateway(),
+		BlobStore:          NewInMemoryBlobStore(),
+		ExtractionQueue:    &RecordingExtractionQueue{},
+		SavedSearchGateway: NewInMemorySavedSearchGateway(),
+		ShortlistGateway:   NewInMemoryShortlistGateway(),
+		ScorecardGateway:   &InMemoryScorecardGateway{},
+		Clock:              domain.SystemClock{},
+	}
+}
+
+type InMemoryTalentGateway struct {
+	talents map[string]domain.Talent
+	outbox  []domain.TalentEvent
+	pingErr error
+}
+
+func NewInMemoryTalentGateway() *InMemoryTalentGateway {
+	return &InMemoryTalentGateway{
+		talents: make(map[string]domain.Talent),
+	}
+}
+
+func (g *InMemoryTalentGateway) Save(ctx context.Context, talent *domain.Talent) error {
+	if g.talents[talent.Id.String()].Version != talent.Version {
+		return domain.ErrVersionConflict
+	}
+	talent.Version++
+	g.outbox = append(g.outbox, talent.PullEvents()...)
+	g.talents[talent.Id.String()] = *talent
+	return nil
+}
+func (g *InMemoryTalentGateway) Delete(ctx context.Context, id string, version int64) error {
+	talent, exists := g.talents[id]
+	if !exists {
+		return domain.ErrTalentNotFound
+	}
+	if talent.Version != version {
+		return domain.ErrVersionConflict
+	}
+	delete(g.talents, id)
+	g.outbox = append(g.outbox, domain.NewTalentEvent(domain.EventTalentDeleted, id, nil))
+	return nil
+}
+func (g *InMemoryTalentGateway) GetTalents(ctx context.Context, limit int, cursor string, sort domain.TalentSort) ([]domain.Talent, string, error) {
+	var talents []domain.Talent
+	for _, t := range g.talents {
+		talents = append(talents, t)
+	}
+	slices.SortFunc(talents, func(a, b domain.Talent) int { return sort.Compare(&a, &b) })
+	if cursor != "" {
+		values, id, err := sort.DecodeCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		after := slices.IndexFunc(talents, func(t domain.Talent) bool { return sort.After(&t, values, id) })
+		if after < 0 {
+			after = len(talents)
+		}
+		talents = talents[after:]
+	}
+	if len(talents) < limit {
+		return talents, "", nil
+	}
+	next, err := sort.EncodeCursor(&talents[limit-1])
+	return talents[:limit], next, err
+}
+func (g *InMemoryTalentGateway) GetTalentById(ctx context.Context, id string) (*domain.Talent, error) {
+	if talent, exists := g.talents[id]; exists {
+		return &talent, nil
+	}
+	return nil, domain.ErrTalentNotFound
+}
+func (g *InMemoryTalentGateway) GetTalentsByTags(ctx context.Context, tags []string, limit int) ([]domain.Talent, error) {
+	var talents []domain.Talent
+	for _, t := range g.talents {
+		if len(talents) < limit && slices.ContainsFunc(t.Tags, func(tag string) bool { return slices.Contains(tags, tag) }) {
+			talents = append(talents, t)
+		}
+	}
+	return talents, nil
+}
+func (g *InMemoryTalentGateway) FindTalentsByContact(ctx context.Context, keys []string, limit int) ([]domain.Talent, error) {
+	var talents []domain.Talent
+	for _, t := range g.talents {
+		if len(talents) < limit && slices.ContainsFunc(t.ContactKeys, func(key string) bool { return slices.Contains(keys, key) }) {
+			talents = append(talents, t)
+		}
+	}
+	return talents, nil
+}
+func (g *InMemoryTalentGateway) Ping(ctx context.Context) error {
+	return g.pingErr
+}
+
+func (g *InMemoryTalentGateway) GetStats(ctx context.Context, query domain.StatsQuery) (*domain.Stats, error) {
+	accumulator := domain.NewStatsAccumulator(query)
+	for _, talent := range g.talents {
+		accumulator.Add(talent)
+	}
+	return accumulator.Result(), nil
+}
+
+type InMemoryIdempotencyGateway struct {
+	mu      sync.Mutex
+	records map[string]domain.IdempotencyRecord
+}
+
+func NewInMemoryIdempotencyGateway() *InMemoryIdempotencyGateway {
+	return &InMemoryIdempotencyGateway{
+		records: make(map[string]domain.IdempotencyRecord),
+	}
+}
+
+func (g *InMemoryIdempotencyGateway) ReserveIdempotencyKey(ctx context.Context, record domain.IdempotencyRecord) (*domain.IdempotencyRecord, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if existing, exists := g.records[record.Key]; exists && !existing.IsExpired(record.CreatedAt) {
+		return &existing, nil
+	}
+	g.records[record.Key] = record
+	return nil, nil
+}
+func (g *InMemoryIdempotencyGateway) SaveIdempotencyRecord(ctx context.Context, record domain.IdempotencyRecord) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.records[record.Key] = record
+	return nil
+}
+func (g *InMemoryIdempotencyGateway) DeleteIdempotencyRecord(ctx context.Context, key string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.records, key)
+	return nil
+}
+
+type InMemoryWebhookGateway struct {
+	subscriptions map[string]domain.WebhookSubscription
+	deliveries    map[string]domain.WebhookDelivery
+}
+
+func NewInMemoryWebhookGateway() *InMemoryWebhookGateway {
+	return &InMemoryWebhookGateway{
+		subscriptions: make(map[string]domain.WebhookSubscription),
+		deliveries:    make(map[string]domain.WebhookDelivery),
+	}
+}
+
+func (g *InMemoryWebhookGateway) SaveSubscription(ctx context.Context, subscription domain.WebhookSubscription) error {
+	g.subscriptions[subscription.Id.String()] = subscription
+	return nil
+}
+func (g *InMemoryWebhookGateway) GetSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	var subscriptions []domain.WebhookSubscription
+	for _, s := range g.subscriptions {
+		subscriptions = append(subscriptions, s)
+	}
+	return subscriptions, nil
+}
+func (g *InMemoryWebhookGateway) GetSubscriptionById(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	if subscription, exists := g.subscriptions[id]; exists {
+		return &subscription, nil
+	}
+	return nil, domain.ErrWebhookNotFound
+}
+func (g *InMemoryWebhookGateway) DeleteSubscription(ctx context.Context, id string) error {
+	if _, exists := g.subscriptions[id]; !exists {
+		return domain.ErrWebhookNotFound
+	}
+	delete(g.subscriptions, id)
+	return nil
+}
+func (g *InMemoryWebhookGateway) SaveDelivery(ctx context.Context, delivery domain.WebhookDelivery) error {
+	g.deliveries[delivery.Id.String()] = delivery
+	return nil
+}
+func (g *InMemoryWebhookGateway) GetDeliveryById(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	if delivery, exists := g.deliveries[id]; exists {
+		return &delivery, nil
+	}
+	return nil, domain.ErrWebhookDeliveryNotFound
+}
+func (g *InMemoryWebhookGateway) GetDeliveries(ctx context.Context, subscriptionId string, status string, limit int) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+	for _, d := range g.deliveries {
+		if (subscriptionId == "" || d.SubscriptionId == subscriptionId) && (status == "" || d.Status == status) {
+			deliveries = append(deliveries, d)
+		}
+	}
+	return deliveries, nil
+}
+
+func (g *InMemoryWebhookGateway) GetDueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+	for _, d := range g.deliveries {
+		if d.Status == domain.DeliveryPending && !d.NextAttemptAt.After(now) && len(deliveries) < limit {
+			deliveries = append(deliveries, d)
+		}
+	}
+	return deliveries, nil
+}
+
+type RecordingWebhookQueue struct {
+	enqueued []domain.WebhookDelivery
+}
+
+func (q *RecordingWebhookQueue) Enqueue(delivery domain.WebhookDelivery) {
+	q.enqueued = append(q.enqueued, delivery)
+}
+
+type InMemoryTaskGateway struct {
+	tasks map[string]domain.FollowUpTask
+}
+
+func NewInMemoryTaskGateway() *InMemoryTaskGateway {
+	return &InMemoryTaskGateway{
+		tasks: make(map[string]domain.FollowUpTask),
+	}
+}
+
+func (g *InMemoryTaskGateway) SaveTask(ctx context.Context, task domain.FollowUpTask) error {
+	g.tasks[task.Id.String()] = task
+	return nil
+}
+func (g *InMemoryTaskGateway) GetTaskById(ctx context.Context, id string) (*domain.FollowUpTask, error) {
+	if task, exists := g.tasks[id]; exists {
+		return &task, nil
+	}
+	return nil, domain.ErrTaskNotFound
+}
+func (g *InMemoryTaskGateway) GetTasks(ctx context.Context, filter domain.TaskFilter) ([]domain.FollowUpTask, error) {
+	var tasks []domain.FollowUpTask
+	for _, task := range g.tasks {
+		if filter.TalentId != "" && task.TalentId != filter.TalentId {
+			continue
+		}
+		if filter.Assignee != "" && task.Assignee != filter.Assignee {
+			continue
+		}
+		if filter.Done != nil && task.Done != *filter.Done {
+			continue
+		}
+		if !filter.DueBefore.IsZero() && !task.DueAt.Before(filter.DueBefore) {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+func (g *InMemoryTaskGateway) MarkTaskOverdue(ctx context.Context, id string, now time.Time) (bool, error) {
+	task, exists := g.tasks[id]
+	if !exists || !task.MarkOverdue(now) {
+		return false, nil
+	}
+	g.tasks[id] = task
+	return true, nil
+}
+func (g *InMemoryTaskGateway) ClaimDigest(ctx context.Context, digest domain.TaskDigest) (bool, error) {
+	return true, nil
+}
+
+type InMemoryTagGateway struct {
+	tags map[string]domain.Tag
+}
+
+func NewInMemoryTagGateway() *InMemoryTagGateway {
+	return &InMemoryTagGateway{
+		tags: make(map[string]domain.Tag),
+	}
+}
+
+func (g *InMemoryTagGateway) SaveTag(ctx context.Context, tag domain.Tag) error {
+	tag.UsageCount = g.tags[tag.Id.String()].UsageCount
+	g.tags[tag.Id.String()] = tag
+	return nil
+}
+func (g *InMemoryTagGateway) GetTagById(ctx context.Context, id string) (*domain.Tag, error) {
+	if tag, exists := g.tags[id]; exists {
+		return &tag, nil
+	}
+	return nil, domain.ErrTagNotFound
+}
+func (g *InMemoryTagGateway) FindTagsByKeys(ctx context.Context, keys []string) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	for _, tag := range g.tags {
+		if slices.ContainsFunc(keys, func(key string) bool { return slices.Contains(tag.Keys, key) }) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
+func (g *InMemoryTagGateway) SearchTags(ctx context.Context, prefix string, category string, limit int) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	for _, tag := range g.tags {
+		if strings.HasPrefix(domain.TagKey(tag.Name), domain.TagKey(prefix)) && (category == "" || tag.Category == category) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags[:min(limit, len(tags))], nil
+}
+func (g *InMemoryTagGateway) GetAllTags(ctx context.Context) ([]domain.Tag, error) {
+	var tags []domain.Tag
+	for _, tag := range g.tags {
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+func (g *InMemoryTagGateway) DeleteTag(ctx context.Context, id string) error {
+	if _, exists := g.tags[id]; !exists {
+		return domain.ErrTagNotFound
+	}
+	delete(g.tags, id)
+	return nil
+}
+func (g *InMemoryTagGateway) AdjustUsage(ctx context.Context, deltas map[string]int64) error {
+	for id, delta := range deltas {
+		if tag, exists := g.tags[id]; exists {
+			tag.UsageCount += delta
+			g.tags[id] = tag
+		}
+	}
+	return nil
+}
+
+type InMemoryAttachmentGateway struct {
+	attachments map[string]domain.Attachment
+}
+
+func NewInMemoryAttachmentGateway() *InMemoryAttachmentGateway {
+	return &InMemoryAttachmentGateway{
+		attachments: make(map[string]domain.Attachment),
+	}
+}
+
+func (g *InMemoryAttachmentGateway) SaveAttachment(ctx context.Context, attachment domain.Attachment) error {
+	g.attachments[attachment.Id.String()] = attachment
+	return nil
+}
+func (g *InMemoryAttachmentGateway) GetAttachment(ctx context.Context, talentId string, id string) (*domain.Attachment, error) {
+	if attachment, exists := g.attachments[id]; exists && attachment.TalentId == talentId {
+		return &attachment, nil
+	}
+	return nil, domain.ErrAttachmentNotFound
+}
+func (g *InMemoryAttachmentGateway) GetAttachments(ctx context.Context, talentId string) ([]domain.Attachment, error) {
+	var attachments []domain.Attachment
+	for _, attachment := range g.attachments {
+		if attachment.TalentId == talentId {
+			attachments = append(attachments, attachment)
+		}
+	}
+	return attachments, nil
+}
+
+func (g *InMemoryAttachmentGateway) GetPendingAttachments(ctx context.Context, limit int) ([]domain.Attachment, error) {
+	var attachments []domain.Attachment
+	for _, attachment := range g.attachments {
+		if attachment.TextStatus == domain.TextPending && len(attachments) < limit {
+			attachments = append(attachments, attachment)
+		}
+	}
+	return attachments, nil
+}
+
+type RecordingExtractionQueue struct {
+	attachments []domain.Attachment
+}
+
+func (q *RecordingExtractionQueue) Enqueue(attachment domain.Attachment) {
+	q.attachments = append(q.attachments, attachment)
+}
+
+type InMemoryBlobStore struct {
+	blobs map[string][]byte
+}
+
+func NewInMemoryBlobStore() *InMemoryBlobStore {
+	return &InMemoryBlobStore{
+		blobs: make(map[string][]byte),
+	}
+}
+
+func (s *InMemoryBlobStore) Put(ctx context.Context, key string, content io.Reader, contentType string) error {
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	s.blobs[key] = data
+	return nil
+}
+func (s *InMemoryBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	data, exists := s.blobs[key]
+	if !exists {
+		return nil, domain.ErrBlobNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+func (s *InMemoryBlobStore) Exists(ctx context.Context, key string) (bool, error) {
+	_, exists := s.blobs[key]
+	return exists, nil
+}
+
+type InMemorySavedSearchGateway struct {
+	searches map[string]domain.SavedSearch
+}
+
+func NewInMemorySavedSearchGateway() *InMemorySavedSearchGateway {
+	return &InMemorySavedSearchGateway{
+		searches: make(map[string]domain.SavedSearch),
+	}
+}
+
+func (g *InMemorySavedSearchGateway) SaveSavedSearch(ctx context.Context, search domain.SavedSearch) error {
+	g.searches[search.Id.String()] = search
+	return nil
+}
+func (g *InMemorySavedSearchGateway) GetSavedSearchById(ctx context.Context, id string) (*domain.SavedSearch, error) {
+	if search, exists := g.searches[id]; exists {
+		return &search, nil
+	}
+	return nil, domain.ErrSavedSearchNotFound
+}
+func (g *InMemorySavedSearchGateway) GetSavedSearches(ctx context.Context, owner string) ([]domain.SavedSearch, error) {
+	var searches []domain.SavedSearch
+	for _, search := range g.searches {
+		if search.VisibleTo(owner) {
+			searches = append(searches, search)
+		}
+	}
+	slices.SortFunc(searches, func(a, b domain.SavedSearch) int { return strings.Compare(a.Name, b.Name) })
+	return searches, nil
+}
+func (g *InMemorySavedSearchGateway) DeleteSavedSearch(ctx context.Context, id string) error {
+	if _, exists := g.searches[id]; !exists {
+		return domain.ErrSavedSearchNotFound
+	}
+	delete(g.searches, id)
+	return nil
+}
+
+type InMemoryShortlistGateway struct {
+	shortlists map[string]domain.Shortlist
+}
+
+func NewInMemoryShortlistGateway() *InMemoryShortlistGateway {
+	return &InMemoryShortlistGateway{
+		shortlists: make(map[string]domain.Shortlist),
+	}
+}
+
+func (g *InMemoryShortlistGateway) SaveShortlist(ctx context.Context, shortlist *domain.Shortlist) error {
+	if g.shortlists[shortlist.Id.String()].Version != shortlist.Version {
+		return domain.ErrShortlistConflict
+	}
+	shortlist.Version++
+	stored := *shortlist
+	stored.TalentIds = slices.Clone(shortlist.TalentIds)
+	g.shortlists[shortlist.Id.String()] = stored
+	return nil
+}
+func (g *InMemoryShortlistGateway) GetShortlistById(ctx context.Context, id string) (*domain.Shortlist, error) {
+	if shortlist, exists := g.shortlists[id]; exists {
+		shortlist.TalentIds = slices.Clone(shortlist.TalentIds)
+		return &shortlist, nil
+	}
+	return nil, domain.ErrShortlistNotFound
+}
+func (g *InMemoryShortlistGateway) GetShortlists(ctx context.Context, owner string) ([]domain.Shortlist, error) {
+	var shortlists []domain.Shortlist
+	for _, shortlist := range g.shortlists {
+		if shortlist.VisibleTo(owner) {
+			shortlists = append(shortlists, shortlist)
+		}
+	}
+	slices.SortFunc(shortlists, func(a, b domain.Shortlist) int { return strings.Compare(a.Name, b.Name) })
+	return shortlists, nil
+}
+func (g *InMemoryShortlistGateway) DeleteShortlist(ctx context.Context, id string) error {
+	if _, exists := g.shortlists[id]; !exists {
+		return domain.ErrShortlistNotFound
+	}
+	delete(g.shortlists, id)
+	return nil
+}
+
+type InMemoryScorecardGateway struct {
+	scorecards []domain.Scorecard
+}
+
+func (g *InMemoryScorecardGateway) SaveScorecard(ctx context.Context, scorecard domain.Scorecard) error {
+	g.scorecards = append(g.scorecards, scorecard)
+	return nil
+}
+func (g *InMemoryScorecardGateway) GetScorecards(ctx context.Context, talentId string, openingId string) ([]domain.Scorecard, error) {
+	var scorecards []domain.Scorecard
+	for _, scorecard := range g.scorecards {
+		if scorecard.TalentId == talentId && (openingId == "" || scorecard.OpeningId == openingId) {
+			scorecards = append(scorecards, scorecard)
+		}
+	}
+	return scorecards, nil
+}